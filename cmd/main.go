@@ -5,6 +5,7 @@ package main
 
 import (
 	"context"
+	"crypto/subtle"
 	"flag"
 	"fmt"
 	"log"
@@ -12,11 +13,16 @@ import (
 	"time"
 
 	"eth2-crawler/crawler"
+	"eth2-crawler/crawler/crawl"
+	"eth2-crawler/crawler/network"
 	"eth2-crawler/graph"
 	"eth2-crawler/graph/generated"
 	"eth2-crawler/resolver/ipdata"
+	enrStore "eth2-crawler/store/enrstore/mongo"
+	nodeStore "eth2-crawler/store/nodestore/mongo"
 	peerStore "eth2-crawler/store/peerstore/mongo"
 	recordStore "eth2-crawler/store/record/mongo"
+	uptimeStore "eth2-crawler/store/uptimestore/mongo"
 	"eth2-crawler/utils/config"
 	"eth2-crawler/utils/server"
 
@@ -33,6 +39,11 @@ func main() {
 		log.Fatalf("error loading configuration: %s", err.Error())
 	}
 
+	eth2Network, err := network.New(cfg.Network)
+	if err != nil {
+		log.Fatalf("error loading the network profile: %s", err.Error())
+	}
+
 	peerStore, err := peerStore.New(cfg.Database)
 	if err != nil {
 		log.Fatalf("error Initializing the peer store: %s", err.Error())
@@ -43,15 +54,42 @@ func main() {
 		log.Fatalf("error Initializing the record store: %s", err.Error())
 	}
 
+	enrStore, err := enrStore.New(cfg.Database)
+	if err != nil {
+		log.Fatalf("error Initializing the enr store: %s", err.Error())
+	}
+
+	nodeStore, err := nodeStore.New(cfg.Database)
+	if err != nil {
+		log.Fatalf("error Initializing the node store: %s", err.Error())
+	}
+
+	uptimeStore, err := uptimeStore.New(cfg.Database)
+	if err != nil {
+		log.Fatalf("error Initializing the uptime store: %s", err.Error())
+	}
+
+	// the records stored before the network profiles belong to the crawled network
+	err = peerStore.BackfillNetwork(context.TODO(), eth2Network.Name)
+	if err != nil {
+		log.Fatalf("error migrating the peer store: %s", err.Error())
+	}
+	err = historyStore.BackfillNetwork(context.TODO(), eth2Network.Name)
+	if err != nil {
+		log.Fatalf("error migrating the record store: %s", err.Error())
+	}
+
 	resolverService, err := ipdata.New(cfg.Resolver.APIKey, time.Duration(cfg.Resolver.Timeout)*time.Second)
 	if err != nil {
 		log.Fatalf("error Initializing the ip resolver: %s", err.Error())
 	}
 
+	trigger := crawl.NewSnapshotTrigger()
+
 	// TODO collect config from a config files or from command args and pass to Start()
-	go crawler.Start(peerStore, historyStore, resolverService)
+	go crawler.Start(peerStore, historyStore, enrStore, nodeStore, uptimeStore, resolverService, eth2Network, cfg.Crawler, trigger)
 
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(peerStore, historyStore)}))
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: graph.NewResolver(peerStore, historyStore, enrStore, nodeStore, uptimeStore, eth2Network, cfg.ForkReadiness)}))
 
 	router := http.NewServeMux()
 	// TODO: make playground accessible only in Dev mode
@@ -61,6 +99,34 @@ func main() {
 	router.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "{ \"status\": \"up\" }")
 	})
+	if cfg.Server.AdminToken != "" {
+		router.Handle("/admin/snapshot", adminHandler(cfg.Server.AdminToken, func(w http.ResponseWriter, r *http.Request) {
+			if !trigger.Fire() {
+				w.WriteHeader(http.StatusConflict)
+				fmt.Fprintf(w, "{ \"status\": \"pending\" }")
+				return
+			}
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintf(w, "{ \"status\": \"triggered\" }")
+		}))
+	}
 
 	server.Start(context.TODO(), cfg.Server, router)
 }
+
+// adminHandler accepts the POST requests holding the admin token as bearer token
+func adminHandler(token string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		auth := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(auth, []byte("Bearer "+token)) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
@@ -6,25 +6,37 @@ package crawl
 import (
 	"context"
 	"crypto/ecdsa"
+	"eth2-crawler/crawler/gossip"
+	"eth2-crawler/crawler/network"
 	"eth2-crawler/crawler/p2p"
 	reqresp "eth2-crawler/crawler/rpc/request"
 	"eth2-crawler/crawler/util"
 	"eth2-crawler/models"
 	ipResolver "eth2-crawler/resolver"
+	"eth2-crawler/store/enrstore"
+	"eth2-crawler/store/nodestore"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
+	"eth2-crawler/store/uptimestore"
 	"time"
 
 	"github.com/protolambda/zrnt/eth2/beacon/common"
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	ma "github.com/multiformats/go-multiaddr"
 )
 
 type crawler struct {
+	network         *network.Network
 	disc            resolver
 	peerStore       peerstore.Provider
 	historyStore    record.Provider
+	enrStore        enrstore.Provider
+	nodeStore       nodestore.Provider
+	uptimeStore     uptimestore.Provider
 	ipResolver      ipResolver.Provider
 	iter            enode.Iterator
 	nodeCh          chan *enode.Node
@@ -32,6 +44,16 @@ type crawler struct {
 	host            p2p.Host
 	jobs            chan *models.Peer
 	jobsConcurrency int
+	statuses        *statusTracker
+	blockProbing    bool
+	syncThresholds  *models.SyncThresholds
+	observer        *gossip.Observer
+	scheduler       *scheduler
+	retries         int
+	retryInterval   time.Duration
+	retention       time.Duration
+	sweeper         *sweeper
+	estimator       *sizeEstimator
 }
 
 // resolver holds methods of discovery v5
@@ -40,13 +62,17 @@ type resolver interface {
 }
 
 // newCrawler inits new crawler service
-func newCrawler(disc resolver, peerStore peerstore.Provider, historyStore record.Provider,
-	ipResolver ipResolver.Provider, privateKey *ecdsa.PrivateKey, iter enode.Iterator,
-	host p2p.Host, jobConcurrency int) *crawler {
+func newCrawler(eth2Network *network.Network, disc resolver, peerStore peerstore.Provider, historyStore record.Provider,
+	enrStore enrstore.Provider, nodeStore nodestore.Provider, uptimeStore uptimestore.Provider, ipResolver ipResolver.Provider,
+	privateKey *ecdsa.PrivateKey, iter enode.Iterator, host p2p.Host, jobConcurrency int) *crawler {
 	c := &crawler{
+		network:         eth2Network,
 		disc:            disc,
 		peerStore:       peerStore,
 		historyStore:    historyStore,
+		enrStore:        enrStore,
+		nodeStore:       nodeStore,
+		uptimeStore:     uptimeStore,
 		ipResolver:      ipResolver,
 		privateKey:      privateKey,
 		iter:            iter,
@@ -54,6 +80,8 @@ func newCrawler(disc resolver, peerStore peerstore.Provider, historyStore record
 		host:            host,
 		jobs:            make(chan *models.Peer, jobConcurrency),
 		jobsConcurrency: jobConcurrency,
+		statuses:        newStatusTracker(2*network.SlotsPerEpoch*eth2Network.SlotDuration(), eth2Network.SlotDuration()),
+		estimator:       newSizeEstimator(),
 	}
 	return c
 }
@@ -61,11 +89,25 @@ func newCrawler(disc resolver, peerStore peerstore.Provider, historyStore record
 // start runs the crawler
 func (c *crawler) start(ctx context.Context) {
 	doneCh := make(chan enode.Iterator)
-	go c.runIterator(ctx, doneCh, c.iter)
+	// the sweep rounds close the samples of the size estimator, random walks are sampled by time window
+	var window <-chan time.Time
+	if c.sweeper != nil {
+		go c.sweep(ctx)
+	} else {
+		ticker := time.NewTicker(estimateWindow)
+		defer ticker.Stop()
+		window = ticker.C
+		go c.runIterator(ctx, doneCh, c.iter)
+	}
 	for {
 		select {
 		case n := <-c.nodeCh:
+			if c.sweeper == nil && c.isNetworkNode(n) {
+				c.estimator.add(n.ID())
+			}
 			c.storePeer(ctx, n)
+		case <-window:
+			logEstimate(c.estimator.complete())
 		case <-doneCh:
 			// crawling finished
 			log.Info("finished iterator")
@@ -87,22 +129,138 @@ func (c *crawler) runIterator(ctx context.Context, doneCh chan enode.Iterator, i
 }
 
 func (c *crawler) storePeer(ctx context.Context, node *enode.Node) {
-	// only consider the node having tcp port exported
-	if node.TCP() == 0 {
-		return
-	}
-	// filter only eth2 nodes
+	// every node is kept in the discovery records, only the eth2 nodes of the network having tcp port exported become peers
+	var forkName, nextForkName string
 	eth2Data, err := util.ParseEnrEth2Data(node)
 	if err != nil { // not eth2 nodes
+		eth2Data = nil
+	} else {
+		forkName, nextForkName = c.network.ClassifyFork(eth2Data)
+	}
+	kind := models.NodeKindEth2
+	switch {
+	case eth2Data == nil:
+		kind = models.NodeKindOther
+	case forkName == network.UnknownFork: // node of another network sharing the discovery DHT
+		log.Debug("found a node on unknown fork digest", log.Ctx{"node": node, "digest": eth2Data.ForkDigest})
+		kind = models.NodeKindEth2Foreign
+	case !hasTCPEndpoint(node):
+		kind = models.NodeKindEth2NoTCP
+	}
+	c.storeDiscoveredNode(ctx, node, eth2Data, kind)
+	// the peers on unknown fork digests are stored, they are never dialed
+	if eth2Data == nil || !hasTCPEndpoint(node) {
 		return
 	}
 	log.Debug("found a eth2 node", log.Ctx{"node": node})
 
 	// get basic info
-	peer, err := models.NewPeer(node, eth2Data)
+	peer, err := models.NewPeer(node, eth2Data, c.network.Name)
+	if err != nil {
+		return
+	}
+	peer.SetFork(forkName, nextForkName, forkName == network.UnknownFork)
+	// a retired peer found again gets one more update, it comes back if that succeeds
+	err = c.peerStore.Recheck(ctx, peer.ID)
+	if err != nil {
+		log.Error("err rechecking peer", log.Ctx{"err": err, "peer": peer.String()})
+	}
+	// replace the record of a known peer when it is newer
+	previous, err := c.peerStore.UpdateENR(ctx, peer)
+	if err != nil {
+		log.Error("err updating peer record", log.Ctx{"err": err, "peer": peer.String()})
+		return
+	}
+	if previous != nil {
+		c.storeENRHistory(ctx, previous)
+		return
+	}
+	// save to db if not exists
+	err = c.peerStore.Create(ctx, peer)
+	if err != nil {
+		log.Error("err inserting peer", log.Ctx{"err": err, "peer": peer.String()})
+	}
+}
+
+// storeDiscoveredNode keeps the discovery record of the node
+func (c *crawler) storeDiscoveredNode(ctx context.Context, node *enode.Node, eth2Data *common.Eth2Data, kind string) {
+	entries := util.ParseEnrEntries(node)
+	err := c.nodeStore.Upsert(ctx, models.NewDiscoveredNode(node, eth2Data, kind, c.network.Name, entries))
+	if err != nil {
+		log.Error("err inserting discovered node", log.Ctx{"err": err, "node_id": node.ID()})
+	}
+}
+
+// connTransport returns the transport of the open connection to the peer
+func (c *crawler) connTransport(id peer.ID) string {
+	for _, conn := range c.host.Network().ConnsToPeer(id) {
+		if _, err := conn.RemoteMultiaddr().ValueForProtocol(ma.P_QUIC_V1); err == nil {
+			return models.TransportQUIC
+		}
+		return models.TransportTCP
+	}
+	return ""
+}
+
+// hasTCPEndpoint reports whether the node announces a tcp port for its IPv4 or IPv6 address
+func hasTCPEndpoint(node *enode.Node) bool {
+	for _, e := range util.EnodeEndpoints(node) {
+		if e.TCP != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// storeENRHistory keeps the replaced record of the peer.
+// Peers found inbound or stored before the sequence number was tracked have no previous record.
+func (c *crawler) storeENRHistory(ctx context.Context, previous *models.Peer) {
+	if previous.ENRSeq == 0 {
+		return
+	}
+	log.Debug("found a newer node record", log.Ctx{"peer_id": previous.ID, "previous_seq": previous.ENRSeq})
+	err := c.enrStore.Create(ctx, models.NewENRRecord(previous))
 	if err != nil {
+		log.Error("err inserting node record history", log.Ctx{"err": err, "peer_id": previous.ID})
+	}
+}
+
+// listenInbound stores the peers that connected to the crawler like the discovered ones
+func (c *crawler) listenInbound(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case in := <-c.host.InboundPeers():
+			c.storeInboundPeer(ctx, in)
+		}
+	}
+}
+
+func (c *crawler) storeInboundPeer(ctx context.Context, in *p2p.InboundPeer) {
+	// the status doesn't tell about the next fork
+	eth2Data := &common.Eth2Data{
+		ForkDigest:    in.Status.ForkDigest,
+		NextForkEpoch: common.FAR_FUTURE_EPOCH,
+	}
+	forkName, nextForkName := c.network.ClassifyFork(eth2Data)
+	log.Debug("found a eth2 node from inbound connection", log.Ctx{"peer_id": in.ID, "fork": forkName})
+
+	peer, err := models.NewInboundPeer(in.ID, in.Addrs, eth2Data, c.network.Name)
+	if err != nil {
+		log.Debug("unable to create inbound peer", log.Ctx{"peer_id": in.ID, "err": err})
 		return
 	}
+	unknown := forkName == network.UnknownFork
+	peer.SetFork(forkName, nextForkName, unknown)
+	// the head of a peer of another network isn't part of the majority
+	if !unknown {
+		c.statuses.record(in.ID, &in.Status)
+	}
+	err = c.peerStore.Recheck(ctx, peer.ID)
+	if err != nil {
+		log.Error("err rechecking peer", log.Ctx{"err": err, "peer": peer.String()})
+	}
 	// save to db if not exists
 	err = c.peerStore.Create(ctx, peer)
 	if err != nil {
@@ -110,6 +268,48 @@ func (c *crawler) storePeer(ctx context.Context, node *enode.Node) {
 	}
 }
 
+// refreshStatus updates the status announced to peers every slot
+func (c *crawler) refreshStatus(ctx context.Context) {
+	ticker := time.NewTicker(c.network.SlotDuration())
+	defer ticker.Stop()
+	for {
+		c.updateLocalStatus()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// currentSlot returns the wall-clock slot of the network, zero before genesis
+func (c *crawler) currentSlot() common.Slot {
+	slot := c.network.CurrentSlot()
+	if slot < 0 {
+		return 0
+	}
+	return common.Slot(slot)
+}
+
+// updateLocalStatus announces the head of the majority of peers. Until it is known the
+// configured checkpoint, or the genesis one when none is configured, is announced with
+// the wall-clock slot as head slot
+func (c *crawler) updateLocalStatus() {
+	var status common.Status
+	if majority, ok := c.statuses.majority(c.currentSlot(), ""); ok {
+		status = *majority
+	} else {
+		if c.network.Head != nil {
+			status = *c.network.Head
+		}
+		if slot := c.currentSlot(); slot > status.HeadSlot {
+			status.HeadSlot = slot
+		}
+	}
+	status.ForkDigest = c.network.CurrentDigest()
+	c.host.SetStatus(&status)
+}
+
 func (c *crawler) updatePeer(ctx context.Context) {
 	c.runBGWorkersPool(ctx)
 	for {
@@ -124,13 +324,17 @@ func (c *crawler) updatePeer(ctx context.Context) {
 }
 
 func (c *crawler) selectPendingAndExecute(ctx context.Context) {
-	// get peers that was updated 24 hours ago
-	reqs, err := c.peerStore.ListForJob(ctx, time.Hour*24, c.jobsConcurrency)
+	// get peers due for an update
+	reqs, err := c.peerStore.ListForJob(ctx, c.network.Name, c.jobsConcurrency)
 	if err != nil {
 		log.Error("error getting list from peerstore", log.Ctx{"err": err})
 		return
 	}
 	for _, req := range reqs {
+		// skip the peers still queued or being updated
+		if !c.scheduler.claim(req.ID) {
+			continue
+		}
 		select {
 		case <-ctx.Done():
 			log.Error("update selector stopped", log.Ctx{"err": ctx.Err()})
@@ -155,6 +359,7 @@ func (c *crawler) bgWorker(ctx context.Context) {
 			return
 		case req := <-c.jobs:
 			c.updatePeerInfo(ctx, req)
+			c.scheduler.release(req.ID)
 		}
 	}
 }
@@ -170,17 +375,29 @@ func (c *crawler) updatePeerInfo(ctx context.Context, peer *models.Peer) {
 		if peer.GeoLocation == nil {
 			c.updateGeolocation(ctx, peer)
 		}
-	} else {
+	} else if peer.Score > models.ScoreBad {
 		peer.Score--
 	}
-	// remove the node if it has bad score
-	if peer.Score <= models.ScoreBad {
-		log.Info("deleting node for bad score", log.Ctx{"peer_id": peer.ID})
-		err := c.peerStore.Delete(ctx, peer)
+	c.scheduler.schedule(peer, isConnectable)
+	state := peer.State
+	peer.UpdateState(isConnectable, c.retention)
+	if peer.State != state {
+		log.Debug("peer changed state", log.Ctx{"peer_id": peer.ID, "from": state, "to": peer.State})
+		err := c.peerStore.AddTransition(ctx, peer, peer.Transitions[len(peer.Transitions)-1])
 		if err != nil {
-			log.Error("failed on deleting from peerstore", log.Ctx{"err": err})
+			log.Error("failed on storing the state transition", log.Ctx{"err": err, "peer_id": peer.ID})
 		}
-		return
+	}
+	// keep the reason the peer gave when closing the connection
+	if reason, ok := c.host.GoodbyeReason(peer.ID); ok {
+		peer.SetGoodbye(uint64(reason), reason.String())
+	} else if isConnectable {
+		peer.Goodbye = nil
+	}
+	c.updateUptime(ctx, peer, isConnectable)
+	// unreachable peers are kept, their status doesn't tell about the head anymore
+	if peer.Score <= models.ScoreBad {
+		c.statuses.remove(peer.ID)
 	}
 	peer.LastUpdated = time.Now().Unix()
 	err := c.peerStore.Update(ctx, peer)
@@ -192,23 +409,36 @@ func (c *crawler) updatePeerInfo(ctx context.Context, peer *models.Peer) {
 func (c *crawler) collectNodeInfoRetryer(ctx context.Context, peer *models.Peer) bool {
 	count := 0
 	var err error
-	var ag, pv string
-	for count < 20 {
-		time.Sleep(time.Second * 5)
+	var ag, pv, category string
+	for count < c.retries {
+		if count > 0 && !c.waitRetry(ctx, peer.ID) {
+			break
+		}
 		count++
 
 		err = c.host.Connect(ctx, *peer.GetPeerInfo())
 		if err != nil {
+			category = classifyFailure(stageConnect, err)
+			peer.RecordFailure(category, err)
 			continue
 		}
+		if transport := c.connTransport(peer.ID); transport != "" {
+			peer.SetTransport(transport)
+		}
 		// get status
 		var status *common.Status
 		status, err = c.host.FetchStatus(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression))
 		if err != nil || status == nil {
+			category = classifyFailure(stageStatus, err)
+			peer.RecordFailure(category, err)
 			continue
 		}
+		c.statuses.record(peer.ID, status)
+		peer.SetChainStatus(status)
 		ag, err = c.host.GetAgentVersion(peer.ID)
 		if err != nil {
+			category = classifyFailure(stageIdentify, err)
+			peer.RecordFailure(category, err)
 			continue
 		} else {
 			peer.SetUserAgent(ag)
@@ -216,23 +446,76 @@ func (c *crawler) collectNodeInfoRetryer(ctx context.Context, peer *models.Peer)
 
 		pv, err = c.host.GetProtocolVersion(peer.ID)
 		if err != nil {
+			category = classifyFailure(stageIdentify, err)
+			peer.RecordFailure(category, err)
 			continue
 		} else {
 			peer.SetProtocolVersion(pv)
 		}
-		// set sync status
-		peer.SetSyncStatus(int64(status.HeadSlot))
+		// metadata is optional, peers not answering are still connectable.
+		// It is only fetched again when the ping shows a new sequence number.
+		seq, pingErr := c.host.Ping(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression))
+		if pingErr != nil {
+			log.Debug("unable to ping peer", log.Ctx{"peer_id": peer.ID, "err": pingErr})
+		}
+		if pingErr != nil || peer.Metadata == nil || peer.Metadata.SeqNumber != seq {
+			md, mdErr := c.host.FetchMetadata(c.host.NewStream, ctx, peer, new(reqresp.SnappyCompression))
+			if mdErr != nil {
+				log.Debug("unable to fetch metadata", log.Ctx{"peer_id": peer.ID, "err": mdErr})
+			} else {
+				peer.SetMetadata(uint64(md.SeqNumber), md.Attnets, md.Syncnets)
+			}
+		}
+		trusted := *status
+		if c.blockProbing {
+			trusted.HeadSlot = c.probeBlocks(ctx, peer, status)
+		}
+		// set sync status, the peer doesn't take part in the majority it is compared with
+		majority, _ := c.statuses.majority(c.currentSlot(), peer.ID)
+		peer.SetSyncStatus(&trusted, c.network.CurrentSlot(), majority, c.syncThresholds)
 		log.Info("successfully collected all info", peer.Log())
 		return true
 	}
 	// unsuccessful
 	log.Error("failed on retryer", log.Ctx{
-		"attempt": count,
-		"error":   err,
+		"attempt":  count,
+		"category": category,
+		"error":    err,
 	})
 	return false
 }
 
+// waitRetry waits about the retry interval for the next connection attempt to the peer and clears the dial backoff
+// the swarm set after the failed one, the attempt would fail right away otherwise.
+// It returns false when the context is done.
+func (c *crawler) waitRetry(ctx context.Context, id peer.ID) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(jitter(c.retryInterval)):
+	}
+	if sw, ok := c.host.Network().(*swarm.Swarm); ok {
+		sw.Backoff().Clear(id)
+	}
+	return true
+}
+
+// updateUptime stores the outcome of the update and computes the uptime of the peer
+// from the outcomes of the last month
+func (c *crawler) updateUptime(ctx context.Context, peer *models.Peer, success bool) {
+	check := models.NewCheck(peer, success)
+	checks, err := c.uptimeStore.List(ctx, peer.ID, check.Time.Add(-models.UptimeMonth).Unix())
+	if err != nil {
+		log.Error("failed on listing the update outcomes", log.Ctx{"err": err, "peer_id": peer.ID})
+	} else {
+		peer.Uptime = models.NewUptime(append(checks, check), check.Time, c.scheduler.maxRecheck())
+	}
+	err = c.uptimeStore.Create(ctx, check)
+	if err != nil {
+		log.Error("failed on storing the update outcome", log.Ctx{"err": err, "peer_id": peer.ID})
+	}
+}
+
 func (c *crawler) updateGeolocation(ctx context.Context, peer *models.Peer) {
 	geoLoc, err := c.ipResolver.GetGeoLocation(ctx, peer.IP)
 	if err != nil {
@@ -245,17 +528,55 @@ func (c *crawler) updateGeolocation(ctx context.Context, peer *models.Peer) {
 	peer.SetGeoLocation(geoLoc)
 }
 
-func (c *crawler) insertToHistory() {
+func (c *crawler) insertToHistory(bucket int64) {
 	ctx := context.Background()
 	// get count
-	aggregateData, err := c.peerStore.AggregateBySyncStatus(ctx)
+	aggregateData, err := c.peerStore.AggregateBySyncStatus(ctx, c.network.Name)
 	if err != nil {
 		log.Error("error getting sync status", log.Ctx{"err": err})
+		return
 	}
 
-	history := models.NewHistory(aggregateData.Synced, aggregateData.Total)
-	err = c.historyStore.Create(ctx, history)
+	history := models.NewHistory(c.network.Name, bucket, aggregateData.Synced, aggregateData.Total)
+	history.Estimate = c.estimator.estimate()
+	err = c.historyStore.Upsert(ctx, history)
 	if err != nil {
 		log.Error("error inserting sync status", log.Ctx{"err": err})
 	}
 }
+
+// checkFinality groups the peers by finalized checkpoint and flags the ones on minority forks
+func (c *crawler) checkFinality() {
+	ctx := context.Background()
+	aggregateData, err := c.peerStore.AggregateByFinalizedCheckpoint(ctx, c.network.Name)
+	if err != nil {
+		log.Error("error getting finalized checkpoints", log.Ctx{"err": err})
+		return
+	}
+
+	checkpoints := models.GroupByCheckpoint(aggregateData)
+	for _, cp := range checkpoints {
+		if cp.Minority {
+			log.Warn("peers on a minority finalized checkpoint", log.Ctx{
+				"epoch": cp.Epoch,
+				"root":  cp.Root,
+				"count": cp.Count,
+			})
+		}
+	}
+	err = c.peerStore.FlagMinorityFork(ctx, c.network.Name, checkpoints)
+	if err != nil {
+		log.Error("error flagging minority fork peers", log.Ctx{"err": err})
+	}
+}
+
+// flushPropagation adds the gossip propagation stats collected by the observer to the peers
+func (c *crawler) flushPropagation() {
+	ctx := context.Background()
+	for id, stats := range c.observer.Collect() {
+		err := c.peerStore.IncPropagation(ctx, id, stats)
+		if err != nil {
+			log.Error("error updating peer propagation", log.Ctx{"peer_id": id, "err": err})
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+
+	"eth2-crawler/crawler/p2p"
+	"eth2-crawler/models"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+)
+
+// stages of a connection attempt
+const (
+	stageConnect = iota
+	stageStatus
+	stageIdentify
+)
+
+// messages of the failures libp2p only reports as text: the upgrader formats the security and muxer
+// negotiation errors with %s, and multistream.ErrNotSupported is returned by a module we don't depend on
+const (
+	msgSecurityNegotiation = "failed to negotiate security protocol"
+	msgMuxerNegotiation    = "failed to negotiate stream multiplexer"
+	msgNotSupported        = "protocol not supported"
+)
+
+// classifyFailure returns the failure category of an error returned at the given stage of an attempt.
+// A nil error at the status stage is a peer that answered without a status.
+func classifyFailure(stage int, err error) string {
+	if err == nil {
+		if stage == stageStatus {
+			return models.FailureStatusDecode
+		}
+		return models.FailureOther
+	}
+	if stage == stageIdentify {
+		// the identify protocol didn't complete, the peerstore has no entry for the peer
+		if errors.Is(err, peerstore.ErrNotFound) {
+			return models.FailureIdentifyMissing
+		}
+		return models.FailureOther
+	}
+	causes := failureCauses(err)
+	switch {
+	case anyCause(causes, isStreamReset):
+		return models.FailureStreamReset
+	case anyCause(causes, func(err error) bool { return strings.Contains(err.Error(), msgNotSupported) }):
+		return models.FailureProtocolNegotiation
+	}
+	if stage == stageStatus {
+		var decodeErr *p2p.DecodeError
+		switch {
+		case isTimeout(err):
+			return models.FailureDialTimeout
+		case errors.As(err, &decodeErr):
+			return models.FailureStatusDecode
+		}
+		return models.FailureOther
+	}
+
+	switch {
+	case anyCause(causes, func(err error) bool { return errors.Is(err, syscall.ECONNREFUSED) }):
+		return models.FailureConnectionRefused
+	case anyCause(causes, isTimeout):
+		return models.FailureDialTimeout
+	case anyCause(causes, func(err error) bool { return strings.Contains(err.Error(), msgSecurityNegotiation) }):
+		return models.FailureSecurityHandshake
+	case anyCause(causes, func(err error) bool { return strings.Contains(err.Error(), msgMuxerNegotiation) }):
+		return models.FailureProtocolNegotiation
+	}
+	return models.FailureOther
+}
+
+// failureCauses returns the error with the errors of every address of a dial error,
+// a dial error only unwraps to its overall cause
+func failureCauses(err error) []error {
+	causes := []error{err}
+	var dialErr *swarm.DialError
+	if errors.As(err, &dialErr) {
+		for _, te := range dialErr.DialErrors {
+			causes = append(causes, te.Cause)
+		}
+	}
+	return causes
+}
+
+func anyCause(causes []error, match func(error) bool) bool {
+	for _, err := range causes {
+		if err != nil && match(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStreamReset reports whether the peer reset or closed the stream before answering
+func isStreamReset(err error) bool {
+	return errors.Is(err, network.ErrReset) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, swarm.ErrDialTimeout) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
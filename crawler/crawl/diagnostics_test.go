@@ -0,0 +1,79 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"eth2-crawler/crawler/p2p"
+	"eth2-crawler/models"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peerstore"
+	"github.com/libp2p/go-libp2p/p2p/net/swarm"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+// dialError wraps the errors of the addresses into a dial error, the way the swarm reports them
+func dialError(causes ...error) error {
+	err := &swarm.DialError{Peer: "peer"}
+	for _, cause := range causes {
+		err.DialErrors = append(err.DialErrors, swarm.TransportError{
+			Address: ma.StringCast("/ip4/127.0.0.1/tcp/9000"),
+			Cause:   cause,
+		})
+	}
+	return err
+}
+
+func TestClassifyFailure(t *testing.T) {
+	refused := &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	tests := []struct {
+		name     string
+		stage    int
+		err      error
+		category string
+	}{
+		{name: "connection refused", stage: stageConnect, err: dialError(refused), category: models.FailureConnectionRefused},
+		{name: "refused and timed out", stage: stageConnect, err: dialError(timeoutError{}, refused), category: models.FailureConnectionRefused},
+		{name: "dial timeout", stage: stageConnect, err: dialError(timeoutError{}), category: models.FailureDialTimeout},
+		{name: "global dial timeout", stage: stageConnect, err: &swarm.DialError{Peer: "peer", Cause: swarm.ErrDialTimeout}, category: models.FailureDialTimeout},
+		{name: "context deadline", stage: stageConnect, err: fmt.Errorf("dial: %w", context.DeadlineExceeded), category: models.FailureDialTimeout},
+		{name: "security handshake", stage: stageConnect, err: dialError(errors.New("failed to negotiate security protocol: EOF")), category: models.FailureSecurityHandshake},
+		{name: "muxer negotiation", stage: stageConnect, err: dialError(errors.New("failed to negotiate stream multiplexer: protocol not supported")), category: models.FailureProtocolNegotiation},
+		{name: "unknown dial error", stage: stageConnect, err: dialError(errors.New("no good addresses")), category: models.FailureOther},
+		{name: "stream reset", stage: stageStatus, err: fmt.Errorf("read: %w", network.ErrReset), category: models.FailureStreamReset},
+		{name: "stream closed", stage: stageStatus, err: io.EOF, category: models.FailureStreamReset},
+		{name: "truncated response", stage: stageStatus, err: fmt.Errorf("decode: %w", io.ErrUnexpectedEOF), category: models.FailureStreamReset},
+		{name: "status not supported", stage: stageStatus, err: errors.New("protocol not supported"), category: models.FailureProtocolNegotiation},
+		{name: "status timeout", stage: stageStatus, err: fmt.Errorf("read: %w", context.DeadlineExceeded), category: models.FailureDialTimeout},
+		{name: "status read timeout", stage: stageStatus, err: timeoutError{}, category: models.FailureDialTimeout},
+		{name: "invalid status", stage: stageStatus, err: &p2p.DecodeError{Err: errors.New("cannot read 8 bytes, 4 beyond scope")}, category: models.FailureStatusDecode},
+		{name: "truncated status", stage: stageStatus, err: &p2p.DecodeError{Err: io.ErrUnexpectedEOF}, category: models.FailureStreamReset},
+		{name: "error response", stage: stageStatus, err: errors.New("error response: rate limited"), category: models.FailureOther},
+		{name: "no status", stage: stageStatus, err: nil, category: models.FailureStatusDecode},
+		{name: "identify missing", stage: stageIdentify, err: fmt.Errorf("error getting agent version:%w", peerstore.ErrNotFound), category: models.FailureIdentifyMissing},
+		{name: "identify invalid", stage: stageIdentify, err: errors.New("error converting interface to string"), category: models.FailureOther},
+		{name: "no error", stage: stageConnect, err: nil, category: models.FailureOther},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.category, classifyFailure(tt.stage, tt.err))
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"sync"
+	"time"
+
+	"eth2-crawler/crawler/util"
+	"eth2-crawler/models"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// estimateWindow is the duration of the samples of the random crawl mode,
+// the sweep mode samples the nodes of every round
+const estimateWindow = time.Hour
+
+// sizeEstimator estimates the network size by capture-recapture of the nodes found in consecutive samples
+type sizeEstimator struct {
+	mu       sync.Mutex
+	current  map[enode.ID]struct{}
+	previous map[enode.ID]struct{}
+	latest   *models.NetworkEstimate
+}
+
+func newSizeEstimator() *sizeEstimator {
+	return &sizeEstimator{
+		current: make(map[enode.ID]struct{}),
+	}
+}
+
+// add records the node in the current sample
+func (e *sizeEstimator) add(id enode.ID) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.current[id] = struct{}{}
+}
+
+// complete closes the current sample and estimates the network size against the previous one
+func (e *sizeEstimator) complete() *models.NetworkEstimate {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.completeLocked()
+}
+
+// completeRound closes a sample made of the given nodes, the ones added since the last sample
+// are dropped. The nodes of a crawl round are known once it completes, while the nodes it sent
+// may still be on their way to add. Consecutive rounds walk the same DHT from the same routing table,
+// the samples aren't independent and the estimate stays close to the nodes found by both rounds.
+func (e *sizeEstimator) completeRound(ids []enode.ID) *models.NetworkEstimate {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.current = make(map[enode.ID]struct{}, len(ids))
+	for _, id := range ids {
+		e.current[id] = struct{}{}
+	}
+	return e.completeLocked()
+}
+
+func (e *sizeEstimator) completeLocked() *models.NetworkEstimate {
+	previous := e.previous
+	e.previous = e.current
+	e.current = make(map[enode.ID]struct{})
+	if len(previous) == 0 || len(e.previous) == 0 {
+		return nil
+	}
+
+	recaptured := 0
+	for id := range e.previous {
+		if _, ok := previous[id]; ok {
+			recaptured++
+		}
+	}
+	estimate, lower, upper := util.ChapmanEstimate(len(previous), len(e.previous), recaptured)
+	e.latest = &models.NetworkEstimate{
+		Time:         time.Now().Unix(),
+		Method:       models.EstimateCaptureRecapture,
+		FirstSample:  len(previous),
+		SecondSample: len(e.previous),
+		Recaptured:   recaptured,
+		Estimate:     estimate,
+		Lower:        lower,
+		Upper:        upper,
+	}
+	return e.latest
+}
+
+// estimate returns the latest estimate, nil until two samples are complete
+func (e *sizeEstimator) estimate() *models.NetworkEstimate {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.latest
+}
+
+// isNetworkNode reports whether the node announces a fork of the crawled network
+func (c *crawler) isNetworkNode(n *enode.Node) bool {
+	eth2Data, err := util.ParseEnrEth2Data(n)
+	if err != nil {
+		return false
+	}
+	_, ok := c.network.ForkByDigest(eth2Data.ForkDigest)
+	return ok
+}
+
+// logEstimate logs the estimate of a completed sample, nil until two samples are complete
+func logEstimate(estimate *models.NetworkEstimate) {
+	if estimate == nil {
+		return
+	}
+	log.Info("estimated network size", log.Ctx{
+		"estimate":   int(estimate.Estimate),
+		"lower":      int(estimate.Lower),
+		"upper":      int(estimate.Upper),
+		"recaptured": estimate.Recaptured,
+	})
+}
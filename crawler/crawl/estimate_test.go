@@ -0,0 +1,88 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func nodeID(i int) enode.ID {
+	var id enode.ID
+	id[0], id[1] = byte(i>>8), byte(i)
+	return id
+}
+
+func TestSizeEstimator(t *testing.T) {
+	e := newSizeEstimator()
+	for i := 0; i < 100; i++ {
+		e.add(nodeID(i))
+	}
+	assert.Nil(t, e.complete())
+	assert.Nil(t, e.estimate())
+
+	for i := 50; i < 150; i++ {
+		e.add(nodeID(i))
+	}
+	estimate := e.complete()
+	require.NotNil(t, estimate)
+	assert.Equal(t, 100, estimate.FirstSample)
+	assert.Equal(t, 100, estimate.SecondSample)
+	assert.Equal(t, 50, estimate.Recaptured)
+	assert.Equal(t, estimate, e.estimate())
+}
+
+func TestSizeEstimatorRound(t *testing.T) {
+	e := newSizeEstimator()
+	first := make([]enode.ID, 0, 100)
+	for i := 0; i < 100; i++ {
+		first = append(first, nodeID(i))
+	}
+	assert.Nil(t, e.completeRound(first))
+
+	// nodes added late by the crawl loop don't leak into the next round
+	e.add(nodeID(99))
+	e.add(nodeID(1000))
+	second := make([]enode.ID, 0, 60)
+	for i := 80; i < 140; i++ {
+		second = append(second, nodeID(i))
+	}
+	estimate := e.completeRound(second)
+	require.NotNil(t, estimate)
+	assert.Equal(t, 100, estimate.FirstSample)
+	assert.Equal(t, 60, estimate.SecondSample)
+	assert.Equal(t, 20, estimate.Recaptured)
+}
+
+// TestSizeEstimatorConcurrent adds nodes while samples are completed, run it with -race
+func TestSizeEstimatorConcurrent(t *testing.T) {
+	e := newSizeEstimator()
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				e.add(nodeID(w*1000 + i))
+			}
+		}(w)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			if i%2 == 0 {
+				e.complete()
+			} else {
+				e.completeRound([]enode.ID{nodeID(i)})
+			}
+			e.estimate()
+		}
+	}()
+	wg.Wait()
+}
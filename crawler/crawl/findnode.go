@@ -0,0 +1,217 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"eth2-crawler/crawler/util"
+
+	"github.com/ethereum/go-ethereum/common/mclock"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/discover/v5wire"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	// respTimeout is the time a node has to answer a packet, the one of the discovery package
+	respTimeout = 700 * time.Millisecond
+	// maxPacketSize is the maximum size of a discovery v5 packet
+	maxPacketSize = 1280
+	// maxNodesPackets bounds the NODES packets answering a single request
+	maxNodesPackets = 5
+)
+
+var (
+	// errNoIPv6 is returned for nodes with an IPv6 endpoint only when the client has no IPv6 socket
+	errNoIPv6 = errors.New("no ipv6 socket")
+	// errNoEndpoint is returned for nodes announcing no udp endpoint
+	errNoEndpoint = errors.New("no udp endpoint")
+)
+
+// findnodeClient sends FINDNODE requests of given distances to given nodes. The discovery package
+// only runs lookups, so the requests are sent on sockets of their own, each one running a single
+// request at a time with its own session cache.
+type findnodeClient struct {
+	conns chan *findnodeConn
+}
+
+// findnodeConn is a socket to send discovery v5 requests on, with an IPv6 one for dual-stack crawlers
+type findnodeConn struct {
+	conn      *net.UDPConn
+	conn6     *net.UDPConn
+	localNode *enode.LocalNode
+	codec     *v5wire.Codec
+	reqID     uint32
+}
+
+// newFindnodeClient opens size sockets on the listen address, and on the IPv6 one when it is set.
+// The client has an ephemeral identity, its sessions are not shared with the discovery node.
+func newFindnodeClient(listenAddress, listenAddress6 net.IP, size int) (*findnodeClient, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	c := &findnodeClient{conns: make(chan *findnodeConn, size)}
+	for i := 0; i < size; i++ {
+		conn, err := newFindnodeConn(listenAddress, listenAddress6, key)
+		if err != nil {
+			return nil, err
+		}
+		c.conns <- conn
+	}
+	return c, nil
+}
+
+func newFindnodeConn(listenAddress, listenAddress6 net.IP, key *ecdsa.PrivateKey) (*findnodeConn, error) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: listenAddress})
+	if err != nil {
+		return nil, fmt.Errorf("error listening to udp: %w", err)
+	}
+	var conn6 *net.UDPConn
+	if listenAddress6 != nil {
+		conn6, err = net.ListenUDP("udp6", &net.UDPAddr{IP: listenAddress6})
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("error listening to udp6: %w", err)
+		}
+	}
+	db, err := enode.OpenDB("")
+	if err != nil {
+		return nil, fmt.Errorf("error opening db: %w", err)
+	}
+	ln := enode.NewLocalNode(db, key)
+	return &findnodeConn{
+		conn:      conn,
+		conn6:     conn6,
+		localNode: ln,
+		codec:     v5wire.NewCodec(ln, key, mclock.System{}),
+	}, nil
+}
+
+// findnode returns the nodes of the bucket at the log distance of the node
+func (c *findnodeClient) findnode(n *enode.Node, distance uint) ([]*enode.Node, error) {
+	conn := <-c.conns
+	defer func() { c.conns <- conn }()
+	return conn.findnode(n, distance)
+}
+
+// findnode sends the FINDNODE request, answering the handshake challenge and the pings of the node,
+// and collects the NODES responses
+func (fc *findnodeConn) findnode(n *enode.Node, distance uint) ([]*enode.Node, error) {
+	addr := udpEndpoint(n)
+	if addr == nil {
+		return nil, errNoEndpoint
+	}
+	conn := fc.conn
+	if addr.IP.To4() == nil {
+		if fc.conn6 == nil {
+			return nil, errNoIPv6
+		}
+		conn = fc.conn6
+	}
+	req := &v5wire.Findnode{ReqID: fc.nextReqID(), Distances: []uint{distance}}
+	nonce, err := fc.write(conn, n, addr, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*enode.Node
+	for received, total := 0, 1; received < total; {
+		packet, err := fc.read(conn, addr)
+		if err != nil {
+			return nil, err
+		}
+		switch p := packet.(type) {
+		case *v5wire.Whoareyou:
+			if p.Nonce != nonce { // challenge of a previous request
+				continue
+			}
+			p.Node = n
+			if _, err = fc.write(conn, n, addr, req, p); err != nil {
+				return nil, err
+			}
+		case *v5wire.Ping:
+			pong := &v5wire.Pong{ReqID: p.ReqID, ENRSeq: fc.localNode.Seq(), ToIP: addr.IP, ToPort: uint16(addr.Port)}
+			if _, err = fc.write(conn, n, addr, pong, nil); err != nil {
+				return nil, err
+			}
+		case *v5wire.Nodes:
+			if !bytes.Equal(p.ReqID, req.ReqID) {
+				continue
+			}
+			if received == 0 && p.Total > 1 {
+				total = int(p.Total)
+				if total > maxNodesPackets {
+					total = maxNodesPackets
+				}
+			}
+			received++
+			for _, record := range p.Nodes {
+				node, err := enode.New(enode.ValidSchemes, record)
+				if err != nil || uint(enode.LogDist(node.ID(), n.ID())) != distance {
+					continue
+				}
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return nodes, nil
+}
+
+// udpEndpoint returns the discovery endpoint of the node, the IPv4 one first.
+// The IPv6 one is found from the udp6 key, that the node package doesn't read.
+func udpEndpoint(n *enode.Node) *net.UDPAddr {
+	for _, e := range util.EnodeEndpoints(n) {
+		if e.UDP != 0 {
+			return &net.UDPAddr{IP: e.IP, Port: e.UDP}
+		}
+	}
+	return nil
+}
+
+func (fc *findnodeConn) write(conn *net.UDPConn, n *enode.Node, addr *net.UDPAddr, p v5wire.Packet, challenge *v5wire.Whoareyou) (v5wire.Nonce, error) {
+	packet, nonce, err := fc.codec.Encode(n.ID(), addr.String(), p, challenge)
+	if err != nil {
+		return nonce, fmt.Errorf("can't encode %s packet: %w", p.Name(), err)
+	}
+	_, err = conn.WriteToUDP(packet, addr)
+	return nonce, err
+}
+
+// read returns the next packet sent from the address, the late answers of the nodes queried before are dropped
+func (fc *findnodeConn) read(conn *net.UDPConn, from *net.UDPAddr) (v5wire.Packet, error) {
+	buf := make([]byte, maxPacketSize)
+	err := conn.SetReadDeadline(time.Now().Add(respTimeout))
+	if err != nil {
+		return nil, err
+	}
+	for {
+		size, addr, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			return nil, err
+		}
+		if !addr.IP.Equal(from.IP) || addr.Port != from.Port {
+			continue
+		}
+		_, _, p, err := fc.codec.Decode(buf[:size], from.String())
+		if err != nil {
+			continue
+		}
+		return p, nil
+	}
+}
+
+func (fc *findnodeConn) nextReqID() []byte {
+	fc.reqID++
+	id := make([]byte, 4)
+	binary.BigEndian.PutUint32(id, fc.reqID)
+	return id
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"eth2-crawler/crawler/util"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/robfig/cron/v3"
+)
+
+// SnapshotTrigger requests the history record and the aggregation snapshot outside of the schedule
+type SnapshotTrigger chan struct{}
+
+// NewSnapshotTrigger creates a trigger holding a single pending request
+func NewSnapshotTrigger() SnapshotTrigger {
+	return make(SnapshotTrigger, 1)
+}
+
+// Fire requests a snapshot, it returns false when one is already pending
+func (t SnapshotTrigger) Fire() bool {
+	select {
+	case t <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// takeHistory stores the history record and the aggregation snapshot of the bucket holding t.
+// Records are keyed by bucket, taking them again for the same bucket replaces them.
+func (c *crawler) takeHistory(schedule cron.Schedule, t time.Time) {
+	bucket := util.ScheduleBucket(schedule, t).Unix()
+	c.insertToHistory(bucket)
+	c.insertSnapshot(bucket)
+}
+
+// serveHistory fills the current bucket when it was missed while the crawler was down,
+// then takes the history of the current bucket every time it is triggered.
+// The aggregations describe the peers as they are now, so only the current bucket is recovered.
+// Earlier buckets missed during a longer downtime are left as gaps rather than filled with current data.
+func (c *crawler) serveHistory(ctx context.Context, schedule cron.Schedule, trigger SnapshotTrigger) {
+	bucket := util.ScheduleBucket(schedule, time.Now())
+	taken, err := c.historyStore.HasBucket(ctx, c.network.Name, bucket.Unix())
+	if err != nil {
+		log.Error("error checking history bucket", log.Ctx{"err": err})
+	} else if !taken {
+		log.Info("taking missed history snapshot", log.Ctx{"bucket": bucket})
+		c.takeHistory(schedule, bucket)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-trigger:
+			log.Info("taking triggered history snapshot")
+			c.takeHistory(schedule, time.Now())
+		}
+	}
+}
@@ -0,0 +1,297 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"eth2-crawler/crawler/network"
+	"eth2-crawler/crawler/util"
+	"eth2-crawler/models"
+	"eth2-crawler/store/nodestore"
+	"eth2-crawler/store/peerstore"
+	"eth2-crawler/store/record"
+
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// aggregations answers the aggregations of the history records and snapshots
+type aggregations struct {
+	peerstore.Provider
+}
+
+// discoveryAggregations answers the aggregations of the discovered nodes
+type discoveryAggregations struct {
+	nodestore.Provider
+}
+
+func (aggregations) AggregateBySyncStatus(context.Context, string) (*models.SyncAggregateData, error) {
+	return &models.SyncAggregateData{Total: 10, Synced: 8, Unsynced: 2}, nil
+}
+
+func (aggregations) aggregate(context.Context, string) ([]*models.AggregateData, error) {
+	return []*models.AggregateData{{Name: "name", Count: 1}}, nil
+}
+
+func (a aggregations) AggregateByAgentName(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByCountry(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByOperatingSystem(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByNetworkType(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByGoodbyeReason(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByFailure(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByState(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateBySyncState(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByENRKey(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (a aggregations) AggregateByIPVersion(ctx context.Context, n string) ([]*models.AggregateData, error) {
+	return a.aggregate(ctx, n)
+}
+
+func (discoveryAggregations) AggregateByENRKeys(ctx context.Context, n string, _ int64) ([]*models.AggregateData, error) {
+	return aggregations{}.aggregate(ctx, n)
+}
+
+func (aggregations) AggregateByClientVersion(context.Context, string) ([]*models.ClientVersionAggregation, error) {
+	return nil, nil
+}
+
+func (aggregations) AggregateByFork(context.Context, string) ([]*models.ForkAggregation, error) {
+	return nil, nil
+}
+
+func (aggregations) AggregateQUICAdoption(context.Context, string) ([]*models.QUICAdoption, error) {
+	return nil, nil
+}
+
+func (aggregations) AggregateUptimeByClient(context.Context, string) ([]*models.UptimeDistribution, error) {
+	return nil, nil
+}
+
+func (aggregations) AggregateUptimeByProvider(context.Context, string) ([]*models.UptimeDistribution, error) {
+	return nil, nil
+}
+
+func (aggregations) AggregateByFinalizedCheckpoint(context.Context, string) ([]*models.CheckpointAggregation, error) {
+	return nil, nil
+}
+
+func (aggregations) AggregatePropagationByClient(context.Context, string) ([]*models.ClientPropagation, error) {
+	return nil, nil
+}
+
+func (aggregations) ViewAll(context.Context, string) ([]*models.Peer, error) {
+	return []*models.Peer{{}, {}}, nil
+}
+
+// historyStore keeps a record per bucket like the database
+type historyStore struct {
+	record.Provider
+	mu        sync.Mutex
+	history   []*models.History
+	snapshots []*models.Snapshot
+	stored    chan struct{}
+}
+
+func newHistoryStore() *historyStore {
+	return &historyStore{stored: make(chan struct{}, 16)}
+}
+
+func (s *historyStore) HasBucket(_ context.Context, _ string, bucket int64) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, h := range s.history {
+		if h.Bucket == bucket {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *historyStore) Upsert(_ context.Context, history *models.History) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, h := range s.history {
+		if h.Bucket == history.Bucket {
+			s.history[i] = history
+			return nil
+		}
+	}
+	s.history = append(s.history, history)
+	return nil
+}
+
+func (s *historyStore) UpsertSnapshot(_ context.Context, snapshot *models.Snapshot) error {
+	s.mu.Lock()
+	defer func() {
+		s.mu.Unlock()
+		s.stored <- struct{}{}
+	}()
+	for i, h := range s.snapshots {
+		if h.Bucket == snapshot.Bucket {
+			s.snapshots[i] = snapshot
+			return nil
+		}
+	}
+	s.snapshots = append(s.snapshots, snapshot)
+	return nil
+}
+
+// GetSnapshots returns the snapshots of the network between start and end, the oldest first
+func (s *historyStore) GetSnapshots(_ context.Context, network string, start, end, _ int64, _ ...string) ([]*models.Snapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]*models.Snapshot, 0)
+	for _, snapshot := range s.snapshots {
+		if snapshot.Network == network && snapshot.Time > start && snapshot.Time < end {
+			result = append(result, snapshot)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Time < result[j].Time })
+	return result, nil
+}
+
+func (s *historyStore) records() ([]*models.History, []*models.Snapshot) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*models.History(nil), s.history...), append([]*models.Snapshot(nil), s.snapshots...)
+}
+
+func newHistoryCrawler(store *historyStore) *crawler {
+	return &crawler{
+		network:      &network.Network{Name: "mainnet"},
+		peerStore:    aggregations{},
+		nodeStore:    discoveryAggregations{},
+		historyStore: store,
+		estimator:    newSizeEstimator(),
+	}
+}
+
+// waitStored waits until the given number of records are stored
+func waitStored(t *testing.T, store *historyStore, count int) {
+	for i := 0; i < count; i++ {
+		select {
+		case <-store.stored:
+		case <-time.After(5 * time.Second):
+			t.Fatal("history not stored")
+		}
+	}
+}
+
+func TestServeHistoryRecovery(t *testing.T) {
+	schedule, err := cron.ParseStandard("@hourly")
+	require.NoError(t, err)
+	bucket := util.ScheduleBucket(schedule, time.Now()).Unix()
+
+	tests := []struct {
+		name      string
+		taken     []int64
+		recovered bool
+	}{
+		{name: "current bucket missing", taken: nil, recovered: true},
+		{name: "previous bucket only", taken: []int64{bucket - 3600}, recovered: true},
+		{name: "current bucket taken", taken: []int64{bucket}, recovered: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newHistoryStore()
+			for _, b := range tt.taken {
+				store.history = append(store.history, &models.History{Network: "mainnet", Bucket: b})
+			}
+			c := newHistoryCrawler(store)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			trigger := NewSnapshotTrigger()
+			go c.serveHistory(ctx, schedule, trigger)
+
+			// the triggered snapshot is taken after the recovery
+			require.True(t, trigger.Fire())
+			taken := 1
+			if tt.recovered {
+				taken++
+			}
+			waitStored(t, store, taken)
+
+			// the triggered snapshot replaces the one of the current bucket
+			history, snapshots := store.records()
+			recovered := 0
+			for _, h := range history {
+				if h.Bucket == bucket {
+					recovered++
+				}
+			}
+			assert.Equal(t, 1, recovered)
+			require.Len(t, snapshots, 1)
+			assert.Equal(t, bucket, snapshots[0].Bucket)
+		})
+	}
+}
+
+func TestTriggeredHistoryReadBack(t *testing.T) {
+	schedule, err := cron.ParseStandard("@daily")
+	require.NoError(t, err)
+	store := newHistoryStore()
+	c := newHistoryCrawler(store)
+	// the current bucket is taken, only the trigger stores a snapshot
+	bucket := util.ScheduleBucket(schedule, time.Now()).Unix()
+	store.history = append(store.history, &models.History{Network: "mainnet", Bucket: bucket})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	trigger := NewSnapshotTrigger()
+	start := time.Now().Add(-time.Minute).Unix()
+	go c.serveHistory(ctx, schedule, trigger)
+
+	require.True(t, trigger.Fire())
+	waitStored(t, store, 1)
+
+	snapshots, err := store.GetSnapshots(ctx, "mainnet", start, time.Now().Add(time.Minute).Unix(), 0, "agent_name")
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, bucket, snapshots[0].Bucket)
+	assert.NotEmpty(t, snapshots[0].AgentName)
+	history, _ := store.records()
+	require.Len(t, history, 1)
+	assert.Equal(t, 10, history[0].Eth2Nodes)
+	assert.Equal(t, 8, history[0].SyncNodes)
+}
+
+func TestSnapshotTrigger(t *testing.T) {
+	trigger := NewSnapshotTrigger()
+	assert.True(t, trigger.Fire())
+	// a single request is kept pending
+	assert.False(t, trigger.Fire())
+	<-trigger
+	assert.True(t, trigger.Fire())
+}
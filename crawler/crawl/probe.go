@@ -0,0 +1,82 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"eth2-crawler/crawler/network"
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/models"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+const (
+	// number of slots requested at every step of the history probe,
+	// a few slots are enough to skip the empty ones
+	probeRangeSlots = 8
+	// the distance from the head grows by this factor at every step
+	probeRangeGrowth = 4
+	// maximum number of range requests sent to a peer, enough to reach the genesis of mainnet
+	maxProbeRanges = 12
+)
+
+// probeBlocks requests the claimed head block and walks back from the head,
+// growing the distance at every step, until the peer stops serving blocks.
+// It returns the head slot that can be trusted.
+func (c *crawler) probeBlocks(ctx context.Context, peer *models.Peer, status *common.Status) common.Slot {
+	comp := new(reqresp.SnappyCompression)
+	var servesBlocks, headVerified, headMismatch bool
+	earliest, newest := status.HeadSlot, common.Slot(0)
+	blocks, err := c.host.FetchBlocksByRoot(c.host.NewStream, ctx, peer, comp, []common.Root{status.HeadRoot})
+	if err != nil {
+		log.Debug("unable to fetch head block", log.Ctx{"peer_id": peer.ID, "err": err})
+	}
+	if len(blocks) > 0 {
+		servesBlocks = true
+		headVerified = blocks[0].Slot == status.HeadSlot
+		headMismatch = !headVerified
+		newest = blocks[0].Slot
+		if newest < earliest {
+			earliest = newest
+		}
+	}
+
+	distance := common.Slot(network.SlotsPerEpoch)
+	for i := 0; i < maxProbeRanges; i, distance = i+1, distance*probeRangeGrowth {
+		start := common.Slot(0)
+		if distance < status.HeadSlot {
+			start = status.HeadSlot - distance
+		}
+		blocks, err = c.host.FetchBlocksByRange(c.host.NewStream, ctx, peer, comp, start, probeRangeSlots)
+		if err != nil || len(blocks) == 0 {
+			break
+		}
+		servesBlocks = true
+		for _, b := range blocks {
+			if b.Slot < earliest {
+				earliest = b.Slot
+			}
+			if b.Slot > newest {
+				newest = b.Slot
+			}
+		}
+		if start == 0 {
+			break
+		}
+	}
+	if !servesBlocks {
+		earliest = 0
+	}
+	peer.SetBlockProbe(servesBlocks, headVerified, uint64(earliest))
+
+	// a peer serving another slot for its claimed head root can't be trusted on the claim,
+	// unless none of the blocks it served is newer. A failed head request proves nothing.
+	if headMismatch && newest > 0 {
+		log.Debug("peer didn't serve its claimed head", log.Ctx{"peer_id": peer.ID, "head_slot": status.HeadSlot})
+		return newest
+	}
+	return status.HeadSlot
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"eth2-crawler/crawler/p2p"
+	"eth2-crawler/crawler/rpc/methods"
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/models"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// blockHost serves the blocks between first and last, the head root is the block at headSlot
+type blockHost struct {
+	p2p.Host
+	servesHead  bool
+	headSlot    common.Slot
+	first, last common.Slot
+	ranges      int
+}
+
+func (h *blockHost) NewStream(context.Context, peer.ID, ...protocol.ID) (network.Stream, error) {
+	return nil, errors.New("no stream")
+}
+
+func (h *blockHost) FetchBlocksByRoot(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression,
+	roots []common.Root) ([]*methods.SignedBeaconBlock, error) {
+	if !h.servesHead {
+		return nil, errors.New("resource unavailable")
+	}
+	return []*methods.SignedBeaconBlock{{Slot: h.headSlot}}, nil
+}
+
+func (h *blockHost) FetchBlocksByRange(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression,
+	startSlot common.Slot, count uint64) ([]*methods.SignedBeaconBlock, error) {
+	h.ranges++
+	var blocks []*methods.SignedBeaconBlock
+	for slot := startSlot; slot < startSlot+common.Slot(count); slot++ {
+		if slot >= h.first && slot <= h.last {
+			blocks = append(blocks, &methods.SignedBeaconBlock{Slot: slot})
+		}
+	}
+	return blocks, nil
+}
+
+func TestProbeBlocks(t *testing.T) {
+	tests := []struct {
+		name         string
+		host         *blockHost
+		claimed      common.Slot
+		trusted      common.Slot
+		servesBlocks bool
+		headVerified bool
+		earliest     uint64
+		ranges       int
+	}{
+		{
+			name:         "archive node",
+			host:         &blockHost{servesHead: true, headSlot: 1000, first: 0, last: 1000},
+			claimed:      1000,
+			trusted:      1000,
+			servesBlocks: true,
+			headVerified: true,
+			earliest:     0,
+			ranges:       4,
+		},
+		{
+			name:         "pruned history",
+			host:         &blockHost{servesHead: true, headSlot: 100000, first: 90000, last: 100000},
+			claimed:      100000,
+			trusted:      100000,
+			servesBlocks: true,
+			headVerified: true,
+			earliest:     91808,
+			ranges:       6,
+		},
+		{
+			name:     "no blocks",
+			host:     &blockHost{first: 1, last: 0},
+			claimed:  1000,
+			trusted:  1000,
+			earliest: 0,
+			ranges:   1,
+		},
+		{
+			name:         "head request fails",
+			host:         &blockHost{first: 0, last: 980},
+			claimed:      1000,
+			trusted:      1000,
+			servesBlocks: true,
+			earliest:     0,
+			ranges:       4,
+		},
+		{
+			name:         "head root at another slot",
+			host:         &blockHost{servesHead: true, headSlot: 990, first: 0, last: 990},
+			claimed:      1000,
+			trusted:      990,
+			servesBlocks: true,
+			earliest:     0,
+			ranges:       4,
+		},
+		{
+			name:         "no newer block",
+			host:         &blockHost{servesHead: true, headSlot: 0, first: 1, last: 0},
+			claimed:      1000,
+			trusted:      1000,
+			servesBlocks: true,
+			earliest:     0,
+			ranges:       1,
+		},
+		{
+			name:         "requests are capped",
+			host:         &blockHost{servesHead: true, headSlot: 1 << 40, first: 0, last: 1 << 40},
+			claimed:      1 << 40,
+			trusted:      1 << 40,
+			servesBlocks: true,
+			headVerified: true,
+			earliest:     1<<40 - 32<<22,
+			ranges:       maxProbeRanges,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &crawler{host: tt.host}
+			peer := &models.Peer{ID: "peer"}
+			trusted := c.probeBlocks(context.Background(), peer, &common.Status{HeadSlot: tt.claimed})
+
+			assert.Equal(t, tt.trusted, trusted)
+			assert.Equal(t, tt.ranges, tt.host.ranges)
+			if assert.NotNil(t, peer.BlockProbe) {
+				assert.Equal(t, tt.servesBlocks, peer.BlockProbe.ServesBlocks)
+				assert.Equal(t, tt.headVerified, peer.BlockProbe.HeadVerified)
+				assert.Equal(t, tt.earliest, peer.BlockProbe.EarliestSlot)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"eth2-crawler/models"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// maxJitter is the largest share of a delay added or removed at random,
+// it keeps the peers found at the same time from being updated together
+const maxJitter = 0.2
+
+// scheduler sets the next update of every peer and tracks the peers being updated
+type scheduler struct {
+	mu      sync.Mutex
+	pending map[peer.ID]struct{}
+
+	recheckInterval time.Duration
+	backoffBase     time.Duration
+	maxBackoff      time.Duration
+}
+
+func newScheduler(recheckInterval, backoffBase, maxBackoff time.Duration) *scheduler {
+	return &scheduler{
+		pending:         make(map[peer.ID]struct{}),
+		recheckInterval: recheckInterval,
+		backoffBase:     backoffBase,
+		maxBackoff:      maxBackoff,
+	}
+}
+
+// claim reports whether the peer can be queued, it is false while the peer is being updated
+func (s *scheduler) claim(id peer.ID) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.pending[id]; ok {
+		return false
+	}
+	s.pending[id] = struct{}{}
+	return true
+}
+
+// release is called once the update of the peer is stored
+func (s *scheduler) release(id peer.ID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pending, id)
+}
+
+// schedule sets the next update of the peer after an update.
+// Reachable peers are updated again after the recheck interval, the delay of the others
+// starts at the backoff base and doubles with every consecutive failure.
+func (s *scheduler) schedule(p *models.Peer, success bool) {
+	delay := s.recheckInterval
+	if success {
+		p.FailedChecks = 0
+	} else {
+		p.FailedChecks++
+		delay = s.backoff(p.FailedChecks)
+	}
+	p.NextCheckAt = time.Now().Add(jitter(delay)).Unix()
+}
+
+// maxRecheck is the longest delay between two updates of a reachable peer
+func (s *scheduler) maxRecheck() time.Duration {
+	return time.Duration(float64(s.recheckInterval) * (1 + maxJitter))
+}
+
+// backoff returns the delay after the given number of consecutive failures
+func (s *scheduler) backoff(failures int) time.Duration {
+	delay := s.backoffBase
+	for i := 1; i < failures && delay < s.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > s.maxBackoff {
+		return s.maxBackoff
+	}
+	return delay
+}
+
+func jitter(d time.Duration) time.Duration {
+	return d + time.Duration((rand.Float64()*2-1)*maxJitter*float64(d))
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"testing"
+	"time"
+
+	"eth2-crawler/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedulerClaim(t *testing.T) {
+	s := newScheduler(time.Hour, time.Minute, time.Hour)
+	assert.True(t, s.claim("a"))
+	assert.True(t, s.claim("b"))
+	// a peer being updated isn't queued twice
+	assert.False(t, s.claim("a"))
+
+	s.release("a")
+	assert.True(t, s.claim("a"))
+	// releasing a peer that isn't claimed is a no-op
+	s.release("c")
+	assert.False(t, s.claim("b"))
+}
+
+func TestSchedulerBackoff(t *testing.T) {
+	s := newScheduler(24*time.Hour, 10*time.Minute, 2*time.Hour)
+	tests := []struct {
+		failures int
+		delay    time.Duration
+	}{
+		{failures: 1, delay: 10 * time.Minute},
+		{failures: 2, delay: 20 * time.Minute},
+		{failures: 3, delay: 40 * time.Minute},
+		{failures: 4, delay: 80 * time.Minute},
+		{failures: 5, delay: 2 * time.Hour},
+		{failures: 6, delay: 2 * time.Hour},
+		{failures: 1000, delay: 2 * time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.delay, s.backoff(tt.failures), "failures %d", tt.failures)
+	}
+}
+
+func TestSchedulerSchedule(t *testing.T) {
+	s := newScheduler(24*time.Hour, 10*time.Minute, 2*time.Hour)
+	tests := []struct {
+		name     string
+		failures int
+		success  bool
+		after    int
+		delay    time.Duration
+	}{
+		{name: "reachable", failures: 0, success: true, after: 0, delay: 24 * time.Hour},
+		{name: "recovered", failures: 4, success: true, after: 0, delay: 24 * time.Hour},
+		{name: "first failure", failures: 0, success: false, after: 1, delay: 10 * time.Minute},
+		{name: "third failure", failures: 2, success: false, after: 3, delay: 40 * time.Minute},
+		{name: "capped", failures: 10, success: false, after: 11, delay: 2 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &models.Peer{FailedChecks: tt.failures}
+			now := time.Now().Unix()
+			s.schedule(p, tt.success)
+			assert.Equal(t, tt.after, p.FailedChecks)
+
+			// the next check is spread by up to 20% around the delay
+			margin := int64(maxJitter * tt.delay.Seconds())
+			delay := p.NextCheckAt - now
+			assert.GreaterOrEqual(t, delay, int64(tt.delay.Seconds())-margin-1)
+			assert.LessOrEqual(t, delay, int64(tt.delay.Seconds())+margin+1)
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	d := 10 * time.Minute
+	var below, above bool
+	for i := 0; i < 1000; i++ {
+		j := jitter(d)
+		assert.GreaterOrEqual(t, j, d-d/5)
+		assert.LessOrEqual(t, j, d+d/5)
+		below = below || j < d
+		above = above || j > d
+	}
+	assert.True(t, below)
+	assert.True(t, above)
+}
@@ -7,58 +7,93 @@ package crawl
 import (
 	"context"
 	"crypto/ecdsa"
+	"errors"
+	"eth2-crawler/models"
+	"eth2-crawler/store/enrstore"
+	"eth2-crawler/store/nodestore"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
+	"eth2-crawler/store/uptimestore"
+	"eth2-crawler/utils/config"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
+	"eth2-crawler/crawler/gossip"
+	"eth2-crawler/crawler/network"
 	"eth2-crawler/crawler/p2p"
 	ipResolver "eth2-crawler/resolver"
 
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/libp2p/go-libp2p"
-	ic "github.com/libp2p/go-libp2p-core/crypto"
-	noise "github.com/libp2p/go-libp2p-noise"
-	"github.com/libp2p/go-tcp-transport"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/p2p/security/noise"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	ma "github.com/multiformats/go-multiaddr"
 )
 
 // listenConfig holds configuration for running v5discovry node
 type listenConfig struct {
-	bootNodeAddrs []string
-	listenAddress net.IP
-	listenPORT    int
-	dbPath        string
-	privateKey    *ecdsa.PrivateKey
+	bootNodeAddrs  []string
+	listenAddress  net.IP
+	listenAddress6 net.IP
+	listenPORT     int
+	tcpPort        int
+	dbPath         string
+	privateKey     *ecdsa.PrivateKey
 }
 
 // Initialize initializes the core crawler component
-func Initialize(peerStore peerstore.Provider, historyStore record.Provider, ipResolver ipResolver.Provider, bootNodeAddrs []string) error {
+func Initialize(peerStore peerstore.Provider, historyStore record.Provider, enrStore enrstore.Provider,
+	nodeStore nodestore.Provider, uptimeStore uptimestore.Provider, ipResolver ipResolver.Provider, eth2Network *network.Network,
+	cfg *config.Crawler, trigger SnapshotTrigger) error {
 	ctx := context.Background()
-	pkey, _ := crypto.GenerateKey()
+	pkey, err := loadPrivateKey(cfg.KeyFile)
+	if err != nil {
+		return err
+	}
 	listenCfg := &listenConfig{
-		bootNodeAddrs: bootNodeAddrs,
-		listenAddress: net.IPv4zero,
-		listenPORT:    30304,
-		dbPath:        "",
-		privateKey:    pkey,
+		bootNodeAddrs:  eth2Network.Bootnodes,
+		listenAddress:  net.ParseIP(cfg.ListenAddress),
+		listenAddress6: net.ParseIP(cfg.ListenAddress6),
+		listenPORT:     cfg.UDPPort,
+		tcpPort:        cfg.TCPPort,
+		dbPath:         cfg.NodeDB,
+		privateKey:     pkey,
 	}
 	disc, err := startV5(listenCfg)
 	if err != nil {
 		return err
 	}
 
-	listenAddrs, err := multiAddressBuilder(listenCfg.listenAddress, listenCfg.listenPORT)
+	listenAddrs := make([]ma.Multiaddr, 0, 2)
+	for _, ip := range []net.IP{listenCfg.listenAddress, listenCfg.listenAddress6} {
+		if ip == nil {
+			continue
+		}
+		addr, err := multiAddressBuilder(ip, listenCfg.tcpPort)
+		if err != nil {
+			return err
+		}
+		listenAddrs = append(listenAddrs, addr)
+	}
+	privKey, err := convertToInterfacePrivkey(listenCfg.privateKey)
 	if err != nil {
 		return err
 	}
 	host, err := p2p.NewHost(
-		libp2p.Identity(convertToInterfacePrivkey(listenCfg.privateKey)),
-		libp2p.ListenAddrs(listenAddrs),
-		libp2p.UserAgent("Eth2-Crawler"),
+		libp2p.Identity(privKey),
+		libp2p.ListenAddrs(listenAddrs...),
+		libp2p.UserAgent(cfg.UserAgent),
 		libp2p.Transport(tcp.NewTCPTransport),
+		libp2p.Transport(libp2pquic.NewTransport),
 		libp2p.Security(noise.ID, noise.New),
 		libp2p.NATPortMap(),
 	)
@@ -66,24 +101,98 @@ func Initialize(peerStore peerstore.Provider, historyStore record.Provider, ipRe
 		return err
 	}
 
-	c := newCrawler(disc, peerStore, historyStore, ipResolver, listenCfg.privateKey, disc.RandomNodes(), host, 200)
+	c := newCrawler(eth2Network, disc, peerStore, historyStore, enrStore, nodeStore, uptimeStore, ipResolver, listenCfg.privateKey, disc.RandomNodes(), host, cfg.Concurrency)
+	c.retries = cfg.Retries
+	c.retryInterval = cfg.RetryInterval
+	c.retention = cfg.Retention
+	c.scheduler = newScheduler(cfg.RecheckInterval, cfg.BackoffBase, cfg.MaxBackoff)
+	if cfg.Mode == config.CrawlModeSweep {
+		client, err := newFindnodeClient(listenCfg.listenAddress, listenCfg.listenAddress6, sweepWorkers)
+		if err != nil {
+			return err
+		}
+		bootnodes, err := parseBootNodes(eth2Network.Bootnodes)
+		if err != nil {
+			return err
+		}
+		c.sweeper = newSweeper(client, func() []*enode.Node {
+			return append(disc.AllNodes(), bootnodes...)
+		}, cfg.SweepMinDistance, cfg.SweepEmptyBuckets)
+	}
+	c.blockProbing = cfg.ProbeBlocks
+	c.syncThresholds = &models.SyncThresholds{
+		SyncedSlots:        cfg.Sync.SyncedSlots,
+		BehindSlots:        cfg.Sync.BehindSlots,
+		FinalizedLagEpochs: cfg.Sync.FinalizedLagEpochs,
+	}
+	if cfg.Gossip {
+		c.observer, err = gossip.NewObserver(ctx, host, eth2Network)
+		if err != nil {
+			return err
+		}
+		go c.observer.Start(ctx)
+	}
 	go c.start(ctx)
+	// answer peers with a plausible status and record the ones dialing in
+	go c.refreshStatus(ctx)
+	go c.listenInbound(ctx)
 	// scheduler for updating peer
 	go c.updatePeer(ctx)
 
 	// add scheduler for updating history store
-	scheduler := cron.New()
-	_, err = scheduler.AddFunc("@daily", c.insertToHistory)
+	historySchedule, err := cron.ParseStandard(cfg.HistorySchedule)
 	if err != nil {
 		return err
 	}
+	go c.serveHistory(ctx, historySchedule, trigger)
+	scheduler := cron.New()
+	scheduler.Schedule(historySchedule, cron.FuncJob(func() {
+		c.takeHistory(historySchedule, time.Now())
+	}))
+	// detect chain splits between the finalized checkpoints of peers
+	scheduler.Schedule(cron.Every(cfg.FinalityInterval), cron.FuncJob(c.checkFinality))
+	if c.observer != nil {
+		_, err = scheduler.AddFunc("@every 1m", c.flushPropagation)
+		if err != nil {
+			return err
+		}
+	}
 	scheduler.Start()
 	return nil
 }
 
-func convertToInterfacePrivkey(privkey *ecdsa.PrivateKey) ic.PrivKey {
-	typeAssertedKey := ic.PrivKey((*ic.Secp256k1PrivateKey)(privkey))
-	return typeAssertedKey
+// loadPrivateKey reads the node key from the file, generating it on first use.
+// An ephemeral key is returned when no file is given.
+func loadPrivateKey(path string) (*ecdsa.PrivateKey, error) {
+	if path == "" {
+		return crypto.GenerateKey()
+	}
+	key, err := crypto.LoadECDSA(path)
+	if err == nil {
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("error loading node key: %w", err)
+	}
+
+	key, err = crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, fmt.Errorf("error creating node key directory: %w", err)
+	}
+	err = crypto.SaveECDSA(path, key)
+	if err != nil {
+		return nil, fmt.Errorf("error saving node key: %w", err)
+	}
+	log.Info("generated new node key", log.Ctx{"path": path})
+	return key, nil
+}
+
+func convertToInterfacePrivkey(privkey *ecdsa.PrivateKey) (ic.PrivKey, error) {
+	return ic.UnmarshalSecp256k1PrivateKey(crypto.FromECDSA(privkey))
 }
 
 func multiAddressBuilder(ipAddr net.IP, port int) (ma.Multiaddr, error) {
@@ -0,0 +1,65 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPrivateKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys", "node.key")
+
+	// the first call generates the key and saves it
+	key, err := loadPrivateKey(path)
+	require.NoError(t, err)
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+
+	// the next ones keep the identity
+	loaded, err := loadPrivateKey(path)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.FromECDSA(key), crypto.FromECDSA(loaded))
+}
+
+func TestLoadPrivateKeyInvalid(t *testing.T) {
+	dir := t.TempDir()
+	corrupt := filepath.Join(dir, "corrupt.key")
+	require.NoError(t, ioutil.WriteFile(corrupt, []byte("not a key"), 0600))
+	// a directory can be opened but not read
+	unreadable := filepath.Join(dir, "unreadable.key")
+	require.NoError(t, os.Mkdir(unreadable, 0700))
+
+	for _, path := range []string{corrupt, unreadable} {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			before, err := ioutil.ReadDir(dir)
+			require.NoError(t, err)
+
+			_, err = loadPrivateKey(path)
+			assert.Error(t, err)
+
+			// no new identity is created in place of the invalid one
+			after, err := ioutil.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Equal(t, len(before), len(after))
+		})
+	}
+	content, err := ioutil.ReadFile(corrupt)
+	require.NoError(t, err)
+	assert.Equal(t, "not a key", string(content))
+}
+
+func TestLoadPrivateKeyEphemeral(t *testing.T) {
+	first, err := loadPrivateKey("")
+	require.NoError(t, err)
+	second, err := loadPrivateKey("")
+	require.NoError(t, err)
+	assert.NotEqual(t, crypto.FromECDSA(first), crypto.FromECDSA(second))
+}
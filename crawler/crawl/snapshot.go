@@ -0,0 +1,91 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"eth2-crawler/models"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// insertSnapshot stores the results of all the aggregations of the peers for the bucket.
+// A failed aggregation is left empty in the snapshot.
+func (c *crawler) insertSnapshot(bucket int64) {
+	ctx := context.Background()
+	network := c.network.Name
+	snapshot := models.NewSnapshot(network, bucket)
+
+	aggregations := map[string]struct {
+		fn  func(ctx context.Context, network string) ([]*models.AggregateData, error)
+		res *[]*models.AggregateData
+	}{
+		"agent_name":          {c.peerStore.AggregateByAgentName, &snapshot.AgentName},
+		"country":             {c.peerStore.AggregateByCountry, &snapshot.Country},
+		"operating_system":    {c.peerStore.AggregateByOperatingSystem, &snapshot.OperatingSystem},
+		"network_type":        {c.peerStore.AggregateByNetworkType, &snapshot.NetworkType},
+		"goodbye_reason":      {c.peerStore.AggregateByGoodbyeReason, &snapshot.GoodbyeReason},
+		"failure":             {c.peerStore.AggregateByFailure, &snapshot.Failure},
+		"peer_state":          {c.peerStore.AggregateByState, &snapshot.PeerState},
+		"sync_state":          {c.peerStore.AggregateBySyncState, &snapshot.SyncState},
+		"enr_key":             {c.peerStore.AggregateByENRKey, &snapshot.ENRKey},
+		"ip_version":          {c.peerStore.AggregateByIPVersion, &snapshot.IPVersion},
+		"discovered_enr_keys": {c.aggregateDiscoveredENRKeys, &snapshot.DiscoveredENRKeys},
+	}
+	for name, a := range aggregations {
+		data, err := a.fn(ctx, network)
+		if err != nil {
+			log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": name})
+			continue
+		}
+		*a.res = data
+	}
+
+	var err error
+	snapshot.ClientVersion, err = c.peerStore.AggregateByClientVersion(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "client_version"})
+	}
+	snapshot.Fork, err = c.peerStore.AggregateByFork(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "fork"})
+	}
+	snapshot.QUICAdoption, err = c.peerStore.AggregateQUICAdoption(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "quic_adoption"})
+	}
+	snapshot.UptimeByClient, err = c.peerStore.AggregateUptimeByClient(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "uptime_by_client"})
+	}
+	snapshot.UptimeByProvider, err = c.peerStore.AggregateUptimeByProvider(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "uptime_by_provider"})
+	}
+	snapshot.FinalizedCheckpoint, err = c.peerStore.AggregateByFinalizedCheckpoint(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "finalized_checkpoint"})
+	}
+	snapshot.ClientPropagation, err = c.peerStore.AggregatePropagationByClient(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "client_propagation"})
+	}
+	peers, err := c.peerStore.ViewAll(ctx, network)
+	if err != nil {
+		log.Error("error aggregating peers for snapshot", log.Ctx{"err": err, "aggregation": "subnets"})
+	} else {
+		snapshot.Subnets = models.CountSubnets(peers)
+	}
+
+	err = c.historyStore.UpsertSnapshot(ctx, snapshot)
+	if err != nil {
+		log.Error("error inserting snapshot", log.Ctx{"err": err})
+	}
+}
+
+// aggregateDiscoveredENRKeys counts the record entries of the nodes seen in discovery lately
+func (c *crawler) aggregateDiscoveredENRKeys(ctx context.Context, network string) ([]*models.AggregateData, error) {
+	return c.nodeStore.AggregateByENRKeys(ctx, network, time.Now().Add(-models.DiscoveryWindow).Unix())
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// statusTracker keeps the recent status of every peer to learn the head of the chain
+type statusTracker struct {
+	mu       sync.Mutex
+	statuses map[peer.ID]trackedStatus
+	// statuses older than window are ignored, the head moves on
+	window time.Duration
+	// slot is the slot duration, the head of a status moves on by a slot every slot
+	slot time.Duration
+}
+
+type trackedStatus struct {
+	status common.Status
+	time   time.Time
+}
+
+func newStatusTracker(window, slot time.Duration) *statusTracker {
+	return &statusTracker{
+		statuses: make(map[peer.ID]trackedStatus),
+		window:   window,
+		slot:     slot,
+	}
+}
+
+func (t *statusTracker) record(peerID peer.ID, status *common.Status) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.statuses[peerID] = trackedStatus{status: *status, time: time.Now()}
+}
+
+func (t *statusTracker) remove(peerID peer.ID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.statuses, peerID)
+}
+
+type checkpoint struct {
+	epoch common.Epoch
+	root  common.Root
+}
+
+type head struct {
+	slot common.Slot
+	root common.Root
+}
+
+// majority returns the finalized checkpoint shared by more than half of the recent statuses, leaving out
+// the one of the excluded peer, together with the latest head reached by more than half of them.
+// Heads are moved on by the slots elapsed since their status was received and capped at the current slot,
+// so a single peer can't pull the head forward. It returns false when no checkpoint has the quorum.
+func (t *statusTracker) majority(currentSlot common.Slot, exclude peer.ID) (*common.Status, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	recent := make([]trackedStatus, 0, len(t.statuses))
+	for id, s := range t.statuses {
+		if time.Since(s.time) > t.window {
+			delete(t.statuses, id)
+			continue
+		}
+		if id == exclude {
+			continue
+		}
+		recent = append(recent, s)
+	}
+	quorum := len(recent)/2 + 1
+
+	checkpoints := make(map[checkpoint]int)
+	for _, s := range recent {
+		checkpoints[checkpoint{epoch: s.status.FinalizedEpoch, root: s.status.FinalizedRoot}]++
+	}
+	var finalized checkpoint
+	var finalizedCount int
+	for cp, count := range checkpoints {
+		if count > finalizedCount {
+			finalized, finalizedCount = cp, count
+		}
+	}
+	if len(recent) == 0 || finalizedCount < quorum {
+		return nil, false
+	}
+
+	heads := make([]head, 0, finalizedCount)
+	for _, s := range recent {
+		if s.status.FinalizedEpoch != finalized.epoch || s.status.FinalizedRoot != finalized.root {
+			continue
+		}
+		slot := s.status.HeadSlot + common.Slot(time.Since(s.time)/t.slot)
+		if slot > currentSlot {
+			slot = currentSlot
+		}
+		heads = append(heads, head{slot: slot, root: s.status.HeadRoot})
+	}
+	// the quorum of heads is reached at the quorum-th latest one
+	sort.Slice(heads, func(i, j int) bool { return heads[i].slot > heads[j].slot })
+	best := heads[quorum-1]
+
+	return &common.Status{
+		FinalizedRoot:  finalized.root,
+		FinalizedEpoch: finalized.epoch,
+		HeadRoot:       best.root,
+		HeadSlot:       best.slot,
+	}, true
+}
@@ -0,0 +1,113 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"testing"
+	"time"
+
+	"eth2-crawler/crawler/network"
+	"eth2-crawler/crawler/p2p"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatusTrackerMajority(t *testing.T) {
+	tracker := newStatusTracker(time.Minute, 12*time.Second)
+	_, ok := tracker.majority(1000, "")
+	assert.False(t, ok)
+
+	finalized := common.Root{1}
+	fork := common.Root{2}
+	tracker.record("a", &common.Status{FinalizedRoot: finalized, FinalizedEpoch: 10, HeadRoot: common.Root{3}, HeadSlot: 400})
+	tracker.record("b", &common.Status{FinalizedRoot: finalized, FinalizedEpoch: 10, HeadRoot: common.Root{3}, HeadSlot: 400})
+	tracker.record("c", &common.Status{FinalizedRoot: finalized, FinalizedEpoch: 10, HeadRoot: common.Root{4}, HeadSlot: 401})
+	// a minority fork with a later head doesn't move the majority
+	tracker.record("d", &common.Status{FinalizedRoot: fork, FinalizedEpoch: 11, HeadRoot: common.Root{5}, HeadSlot: 500})
+	// neither does a single peer claiming a head in the future
+	tracker.record("e", &common.Status{FinalizedRoot: finalized, FinalizedEpoch: 10, HeadRoot: common.Root{6}, HeadSlot: 1 << 40})
+
+	majority, ok := tracker.majority(1000, "")
+	require.True(t, ok)
+	assert.Equal(t, finalized, majority.FinalizedRoot)
+	assert.Equal(t, common.Epoch(10), majority.FinalizedEpoch)
+	assert.Equal(t, common.Root{3}, majority.HeadRoot)
+	assert.Equal(t, common.Slot(400), majority.HeadSlot)
+
+	// the head of the peers of the quorum is capped at the current slot
+	tracker.record("a", &common.Status{FinalizedRoot: finalized, FinalizedEpoch: 10, HeadRoot: common.Root{7}, HeadSlot: 1 << 40})
+	majority, ok = tracker.majority(1000, "")
+	require.True(t, ok)
+	assert.Equal(t, common.Slot(401), majority.HeadSlot)
+	majority, ok = tracker.majority(1000, "c")
+	require.True(t, ok)
+	assert.Equal(t, common.Slot(400), majority.HeadSlot)
+	tracker.record("b", &common.Status{FinalizedRoot: finalized, FinalizedEpoch: 10, HeadRoot: common.Root{7}, HeadSlot: 1 << 40})
+	majority, ok = tracker.majority(1000, "")
+	require.True(t, ok)
+	assert.Equal(t, common.Slot(1000), majority.HeadSlot)
+
+	// without a quorum there is no majority
+	tracker.remove("a")
+	tracker.remove("b")
+	tracker.remove("e")
+	_, ok = tracker.majority(1000, "")
+	assert.False(t, ok)
+	// the excluded peer doesn't count
+	majority, ok = tracker.majority(1000, "d")
+	require.True(t, ok)
+	assert.Equal(t, common.Slot(401), majority.HeadSlot)
+}
+
+func TestStatusTrackerHeadMovesOn(t *testing.T) {
+	tracker := newStatusTracker(time.Minute, 12*time.Second)
+	tracker.record("a", &common.Status{HeadSlot: 400})
+	tracker.statuses["a"] = trackedStatus{status: tracker.statuses["a"].status, time: time.Now().Add(-25 * time.Second)}
+
+	majority, ok := tracker.majority(1000, "")
+	require.True(t, ok)
+	assert.Equal(t, common.Slot(402), majority.HeadSlot)
+}
+
+func TestStatusTrackerWindow(t *testing.T) {
+	tracker := newStatusTracker(time.Minute, 12*time.Second)
+	tracker.record("a", &common.Status{HeadSlot: 400})
+	tracker.statuses["a"] = trackedStatus{status: tracker.statuses["a"].status, time: time.Now().Add(-2 * time.Minute)}
+
+	_, ok := tracker.majority(1000, "")
+	assert.False(t, ok)
+	assert.Empty(t, tracker.statuses)
+}
+
+// statusHost keeps the status announced to peers
+type statusHost struct {
+	p2p.Host
+	status *common.Status
+}
+
+func (h *statusHost) SetStatus(status *common.Status) {
+	h.status = status
+}
+
+func TestUpdateLocalStatusGenesis(t *testing.T) {
+	profile, err := network.Profile("mainnet")
+	require.NoError(t, err)
+	host := new(statusHost)
+	c := &crawler{
+		network:  profile,
+		host:     host,
+		statuses: newStatusTracker(time.Minute, profile.SlotDuration()),
+	}
+
+	// without a configured head nor a majority the genesis checkpoint is announced
+	slot := c.currentSlot()
+	c.updateLocalStatus()
+	require.NotNil(t, host.status)
+	assert.Equal(t, profile.CurrentDigest(), host.status.ForkDigest)
+	assert.Equal(t, common.Root{}, host.status.FinalizedRoot)
+	assert.Equal(t, common.Epoch(0), host.status.FinalizedEpoch)
+	assert.GreaterOrEqual(t, uint64(host.status.HeadSlot), uint64(slot))
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"time"
+
+	"eth2-crawler/models"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	// sweepWorkers is the number of nodes queried at the same time
+	sweepWorkers = 16
+	// maxDistance is the log distance of the farthest bucket
+	maxDistance = 256
+	// sweepPause is the time between two rounds
+	sweepPause = time.Minute
+)
+
+// findnoder asks a node for the nodes of its bucket at a log distance
+type findnoder interface {
+	findnode(n *enode.Node, distance uint) ([]*enode.Node, error)
+}
+
+// sweeper crawls the discovery keyspace in rounds. Starting from the seed nodes, every node found is
+// asked for the content of its buckets with a FINDNODE request per distance, from the farthest one,
+// and the nodes of the answers are queried in turn.
+type sweeper struct {
+	client findnoder
+	// seeds returns the nodes a round starts from
+	seeds func() []*enode.Node
+	round int
+	// minDistance is the log distance of the closest bucket asked for, a node id shares
+	// a 16 bit prefix with one node in 65536. The walk of a node ends after emptyBuckets
+	// empty buckets in a row, every bucket is asked for when zero.
+	minDistance  int
+	emptyBuckets int
+}
+
+type sweepResult struct {
+	responsive bool
+	nodes      []*enode.Node
+}
+
+func newSweeper(client findnoder, seeds func() []*enode.Node, minDistance, emptyBuckets int) *sweeper {
+	return &sweeper{
+		client:       client,
+		seeds:        seeds,
+		minDistance:  minDistance,
+		emptyBuckets: emptyBuckets,
+	}
+}
+
+// run sweeps the keyspace once. Every node found is sent to out.
+// The round completes when the frontier of nodes to query is empty.
+func (s *sweeper) run(ctx context.Context, out chan<- *enode.Node) (*models.CrawlRound, []*enode.Node) {
+	s.round++
+	round := &models.CrawlRound{
+		Round:        s.round,
+		Start:        time.Now().Unix(),
+		MinDistance:  s.minDistance,
+		EmptyBuckets: s.emptyBuckets,
+	}
+
+	seen := make(map[enode.ID]*enode.Node)
+	var frontier []*enode.Node
+	add := func(n *enode.Node) bool {
+		if _, ok := seen[n.ID()]; ok {
+			return true
+		}
+		seen[n.ID()] = n
+		// nodes without an udp endpoint are found but can't be queried
+		if udpEndpoint(n) != nil {
+			frontier = append(frontier, n)
+		}
+		select {
+		case out <- n:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	for _, n := range s.seeds() {
+		if !add(n) {
+			return nil, nil
+		}
+	}
+
+	results := make(chan *sweepResult, sweepWorkers)
+	inflight := 0
+	progress := time.NewTicker(time.Minute)
+	defer progress.Stop()
+	for len(frontier) > 0 || inflight > 0 {
+		for len(frontier) > 0 && inflight < sweepWorkers {
+			n := frontier[0]
+			frontier = frontier[1:]
+			inflight++
+			go func() { results <- s.sweepNode(n) }()
+		}
+		select {
+		case r := <-results:
+			inflight--
+			round.Queried++
+			if r.responsive {
+				round.Responsive++
+			}
+			for _, n := range r.nodes {
+				if !add(n) {
+					return nil, nil
+				}
+			}
+		case <-progress.C:
+			log.Info("crawl round in progress", log.Ctx{
+				"round":    round.Round,
+				"queried":  round.Queried,
+				"frontier": len(frontier),
+				"found":    len(seen),
+			})
+		case <-ctx.Done():
+			return nil, nil
+		}
+	}
+
+	round.End = time.Now().Unix()
+	round.Discovered = len(seen)
+	nodes := make([]*enode.Node, 0, len(seen))
+	for _, n := range seen {
+		nodes = append(nodes, n)
+	}
+	return round, nodes
+}
+
+// sweepNode asks the node for its buckets, from the farthest one down to the min distance or
+// until the buckets are empty. A node that doesn't answer is not asked again during the round.
+func (s *sweeper) sweepNode(n *enode.Node) *sweepResult {
+	result := new(sweepResult)
+	empty := 0
+	for d := maxDistance; d >= s.minDistance && (s.emptyBuckets == 0 || empty < s.emptyBuckets); d-- {
+		nodes, err := s.client.findnode(n, uint(d))
+		if err != nil {
+			log.Trace("findnode request failed", log.Ctx{"node": n.ID(), "distance": d, "err": err})
+			break
+		}
+		result.responsive = true
+		if len(nodes) == 0 {
+			empty++
+			continue
+		}
+		empty = 0
+		result.nodes = append(result.nodes, nodes...)
+	}
+	return result
+}
+
+// sweep runs the sweeper rounds and stores the snapshot of every completed round
+func (c *crawler) sweep(ctx context.Context) {
+	// the rounds are numbered on from the last one stored for the network
+	last, err := c.historyStore.LatestCrawlRound(ctx, c.network.Name)
+	if err != nil {
+		log.Error("error loading the last crawl round", log.Ctx{"err": err})
+	}
+	c.sweeper.round = last
+	for {
+		round, nodes := c.sweeper.run(ctx, c.nodeCh)
+		if round == nil {
+			return
+		}
+		round.Network = c.network.Name
+		sample := make([]enode.ID, 0, len(nodes))
+		for _, n := range nodes {
+			if c.isNetworkNode(n) {
+				sample = append(sample, n.ID())
+			}
+		}
+		round.Eth2Nodes = len(sample)
+		log.Info("crawl round completed", round.Log())
+		err := c.historyStore.InsertCrawlRound(ctx, round)
+		if err != nil {
+			log.Error("error storing the crawl round", log.Ctx{"err": err, "round": round.Round})
+		}
+		logEstimate(c.estimator.completeRound(sample))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(sweepPause):
+		}
+	}
+}
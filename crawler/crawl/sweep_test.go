@@ -0,0 +1,268 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"testing"
+
+	"eth2-crawler/crawler/network"
+	"eth2-crawler/models"
+	"eth2-crawler/store/record"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// bucketSize is the number of nodes of a bucket of the discovery table
+	bucketSize = 16
+	// testMinDistance is the closest bucket asked for by default
+	testMinDistance = 240
+)
+
+// dht answers the FINDNODE requests with the buckets of tables filled at random
+type dht struct {
+	mu       sync.Mutex
+	nodes    []*enode.Node
+	tables   map[enode.ID]map[uint][]*enode.Node
+	down     map[enode.ID]bool
+	requests int
+}
+
+func (d *dht) findnode(n *enode.Node, distance uint) ([]*enode.Node, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.requests++
+	if d.down[n.ID()] {
+		return nil, errors.New("timeout")
+	}
+	return d.tables[n.ID()][distance], nil
+}
+
+func newDHT(t *testing.T, size int) *dht {
+	nodes := make([]*enode.Node, 0, size)
+	for i := 0; i < size; i++ {
+		nodes = append(nodes, newTestNode(t, enr.IPv4(net.IP{10, 0, byte(i >> 8), byte(i)}), enr.UDP(9000)))
+	}
+	return newDHTOf(nodes)
+}
+
+// newDHTOf fills the tables of the nodes at random
+func newDHTOf(nodes []*enode.Node) *dht {
+	d := &dht{
+		nodes:  nodes,
+		tables: make(map[enode.ID]map[uint][]*enode.Node),
+		down:   make(map[enode.ID]bool),
+	}
+	for _, n := range d.nodes {
+		table := make(map[uint][]*enode.Node)
+		for _, i := range rand.Perm(len(d.nodes)) {
+			other := d.nodes[i]
+			distance := uint(enode.LogDist(n.ID(), other.ID()))
+			if distance > 0 && len(table[distance]) < bucketSize {
+				table[distance] = append(table[distance], other)
+			}
+		}
+		d.tables[n.ID()] = table
+	}
+	return d
+}
+
+func newTestNode(t *testing.T, entries ...enr.Entry) *enode.Node {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	var r enr.Record
+	for _, e := range entries {
+		r.Set(e)
+	}
+	require.NoError(t, enode.SignV4(&r, key))
+	n, err := enode.New(enode.ValidSchemes, &r)
+	require.NoError(t, err)
+	return n
+}
+
+func (d *dht) seeds() []*enode.Node {
+	return d.nodes[:1]
+}
+
+func collect(out chan *enode.Node) (map[enode.ID]bool, chan struct{}) {
+	found := make(map[enode.ID]bool)
+	done := make(chan struct{})
+	go func() {
+		for n := range out {
+			found[n.ID()] = true
+		}
+		close(done)
+	}()
+	return found, done
+}
+
+func TestSweepFindsAllNodes(t *testing.T) {
+	d := newDHT(t, 500)
+	s := newSweeper(d, d.seeds, testMinDistance, 0)
+	out := make(chan *enode.Node)
+	found, done := collect(out)
+
+	round, nodes := s.run(context.Background(), out)
+	close(out)
+	<-done
+	require.NotNil(t, round)
+	assert.Equal(t, 1, round.Round)
+	assert.Equal(t, len(d.nodes), round.Discovered)
+	assert.Equal(t, len(d.nodes), round.Queried)
+	assert.Equal(t, len(d.nodes), round.Responsive)
+	assert.Len(t, nodes, len(d.nodes))
+	assert.Len(t, found, len(d.nodes))
+	// every bucket down to the min distance is asked for
+	assert.Equal(t, len(d.nodes)*(maxDistance-testMinDistance+1), d.requests)
+	assert.Equal(t, testMinDistance, round.MinDistance)
+	assert.Equal(t, 0, round.EmptyBuckets)
+}
+
+func TestSweepEmptyBuckets(t *testing.T) {
+	d := newDHT(t, 500)
+	s := newSweeper(d, d.seeds, testMinDistance, 3)
+	out := make(chan *enode.Node)
+	_, done := collect(out)
+
+	round, _ := s.run(context.Background(), out)
+	close(out)
+	<-done
+	require.NotNil(t, round)
+	assert.Equal(t, len(d.nodes), round.Discovered)
+	// the walk of every node stops once its buckets are empty
+	assert.Less(t, d.requests, len(d.nodes)*(maxDistance-testMinDistance+1))
+	assert.Equal(t, 3, round.EmptyBuckets)
+}
+
+func TestSweepUnresponsiveNodes(t *testing.T) {
+	d := newDHT(t, 200)
+	for _, n := range d.nodes[1:50] {
+		d.down[n.ID()] = true
+	}
+	s := newSweeper(d, d.seeds, testMinDistance, 0)
+	out := make(chan *enode.Node)
+	_, done := collect(out)
+
+	round, _ := s.run(context.Background(), out)
+	close(out)
+	<-done
+	require.NotNil(t, round)
+	assert.Equal(t, round.Discovered, round.Queried)
+	assert.Equal(t, round.Queried-49, round.Responsive)
+}
+
+func TestSweepIPv6OnlyNodes(t *testing.T) {
+	nodes := make([]*enode.Node, 0, 200)
+	for i := 0; i < cap(nodes); i++ {
+		if i%2 == 0 {
+			nodes = append(nodes, newTestNode(t, enr.IPv4(net.IP{10, 0, 0, byte(i)}), enr.UDP(9000)))
+			continue
+		}
+		ip := net.ParseIP(fmt.Sprintf("2001:db8::%x", i))
+		nodes = append(nodes, newTestNode(t, enr.IPv6(ip), enr.UDP6(9000)))
+	}
+	d := newDHTOf(nodes)
+	s := newSweeper(d, d.seeds, testMinDistance, 0)
+	out := make(chan *enode.Node)
+	_, done := collect(out)
+
+	round, _ := s.run(context.Background(), out)
+	close(out)
+	<-done
+	require.NotNil(t, round)
+	// the nodes announcing an IPv6 endpoint only are queried as well
+	assert.Equal(t, len(nodes), round.Discovered)
+	assert.Equal(t, len(nodes), round.Queried)
+}
+
+func TestSweepCanceled(t *testing.T) {
+	d := newDHT(t, 100)
+	s := newSweeper(d, d.seeds, testMinDistance, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	round, nodes := s.run(ctx, make(chan *enode.Node))
+	assert.Nil(t, round)
+	assert.Nil(t, nodes)
+}
+
+// roundStore keeps the crawl rounds, the round stored last is numbered last
+type roundStore struct {
+	record.Provider
+	last   int
+	rounds []*models.CrawlRound
+	stored func()
+}
+
+func (s *roundStore) LatestCrawlRound(context.Context, string) (int, error) {
+	return s.last, nil
+}
+
+func (s *roundStore) InsertCrawlRound(_ context.Context, round *models.CrawlRound) error {
+	s.rounds = append(s.rounds, round)
+	s.stored()
+	return nil
+}
+
+func TestSweepRoundsGoOn(t *testing.T) {
+	d := newDHT(t, 50)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := &roundStore{last: 41, stored: cancel}
+	c := &crawler{
+		network:      &network.Network{Name: "mainnet"},
+		sweeper:      newSweeper(d, d.seeds, testMinDistance, 0),
+		historyStore: store,
+		nodeCh:       make(chan *enode.Node),
+		estimator:    newSizeEstimator(),
+	}
+	go func() {
+		for range c.nodeCh {
+		}
+	}()
+
+	// the round numbers go on from the last stored round after a restart
+	c.sweep(ctx)
+	close(c.nodeCh)
+	require.Len(t, store.rounds, 1)
+	assert.Equal(t, 42, store.rounds[0].Round)
+	assert.Equal(t, "mainnet", store.rounds[0].Network)
+}
+
+func TestFindnodeClient(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	ln := enode.NewLocalNode(db, key)
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	require.NoError(t, err)
+	ln.SetStaticIP(net.IPv4(127, 0, 0, 1))
+	ln.SetFallbackUDP(conn.LocalAddr().(*net.UDPAddr).Port)
+	disc, err := discover.ListenV5(conn, ln, discover.Config{PrivateKey: key})
+	require.NoError(t, err)
+	defer disc.Close()
+
+	client, err := newFindnodeClient(net.IPv4(127, 0, 0, 1), nil, 1)
+	require.NoError(t, err)
+	// a node answers the distance 0 with its own record
+	nodes, err := client.findnode(disc.Self(), 0)
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	assert.Equal(t, disc.Self().ID(), nodes[0].ID())
+	// the session of the handshake is reused
+	nodes, err = client.findnode(disc.Self(), maxDistance)
+	require.NoError(t, err)
+	assert.Empty(t, nodes)
+}
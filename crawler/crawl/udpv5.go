@@ -10,10 +10,12 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/p2p/netutil"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -53,17 +55,105 @@ func getDiscoveryConfig(listenCfg *listenConfig) (*enode.LocalNode, *discover.Co
 	return ln, cfg, nil
 }
 
-// listen opens an udp connections on given address
-func listen(cfg *listenConfig) (*net.UDPConn, error) {
-	udpAddr := &net.UDPAddr{
-		IP:   cfg.listenAddress,
-		Port: cfg.listenPORT,
-	}
-	conn, err := net.ListenUDP("udp", udpAddr)
+// listen opens an udp connection on the given address. When an IPv6 address is configured too,
+// the connection listens on both and answers every packet from the socket it came from.
+func listen(cfg *listenConfig) (discover.UDPConn, error) {
+	// "udp" binds a dual stack socket on "0.0.0.0", which takes the port of the IPv6 socket too
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: cfg.listenAddress, Port: cfg.listenPORT})
 	if err != nil {
 		return nil, fmt.Errorf("error listening to udp: %w", err)
 	}
-	return conn, nil
+	if cfg.listenAddress6 == nil {
+		return conn, nil
+	}
+	// udp6 sockets are IPv6 only, "::" doesn't clash with the IPv4 socket of the same port
+	conn6, err := net.ListenUDP("udp6", &net.UDPAddr{IP: cfg.listenAddress6, Port: cfg.listenPORT})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("error listening to udp6: %w", err)
+	}
+	return newDualConn(conn, conn6), nil
+}
+
+// dualConn merges the packets of an IPv4 and an IPv6 socket, writes go through the socket
+// of the destination address family
+type dualConn struct {
+	v4, v6  *net.UDPConn
+	packets chan *udpPacket
+	closed  chan struct{}
+	once    sync.Once
+}
+
+type udpPacket struct {
+	data []byte
+	addr *net.UDPAddr
+	err  error
+}
+
+func newDualConn(v4, v6 *net.UDPConn) *dualConn {
+	c := &dualConn{
+		v4:      v4,
+		v6:      v6,
+		packets: make(chan *udpPacket, 64),
+		closed:  make(chan struct{}),
+	}
+	go c.read(v4)
+	go c.read(v6)
+	return c
+}
+
+func (c *dualConn) read(conn *net.UDPConn) {
+	for {
+		buf := make([]byte, maxPacketSize)
+		n, addr, err := conn.ReadFromUDP(buf)
+		select {
+		case c.packets <- &udpPacket{data: buf[:n], addr: addr, err: err}:
+		case <-c.closed:
+			return
+		}
+		if err != nil && !netutil.IsTemporaryError(err) {
+			return
+		}
+	}
+}
+
+// ReadFromUDP returns the next packet received on either socket
+func (c *dualConn) ReadFromUDP(b []byte) (int, *net.UDPAddr, error) {
+	select {
+	case p := <-c.packets:
+		if p.err != nil {
+			return 0, nil, p.err
+		}
+		return copy(b, p.data), p.addr, nil
+	case <-c.closed:
+		return 0, nil, net.ErrClosed
+	}
+}
+
+// WriteToUDP sends the packet from the socket of the address family of addr
+func (c *dualConn) WriteToUDP(b []byte, addr *net.UDPAddr) (int, error) {
+	if addr.IP.To4() == nil {
+		return c.v6.WriteToUDP(b, addr)
+	}
+	return c.v4.WriteToUDP(b, addr)
+}
+
+// Close closes both sockets
+func (c *dualConn) Close() error {
+	var err error
+	c.once.Do(func() {
+		close(c.closed)
+		err = c.v4.Close()
+		if err6 := c.v6.Close(); err == nil {
+			err = err6
+		}
+	})
+	return err
+}
+
+// LocalAddr returns the address of the IPv4 socket
+func (c *dualConn) LocalAddr() net.Addr {
+	return c.v4.LocalAddr()
 }
 
 // parseBootNodes parse bootnodes from []string
@@ -0,0 +1,75 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package crawl
+
+import (
+	"errors"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDualConn(t *testing.T) {
+	conn, err := listen(&listenConfig{listenAddress: net.IPv4(127, 0, 0, 1), listenAddress6: net.IPv6loopback})
+	if err != nil {
+		t.Skipf("no loopback socket: %v", err)
+	}
+	dual, ok := conn.(*dualConn)
+	require.True(t, ok)
+	defer dual.Close()
+
+	for _, network := range []string{"udp4", "udp6"} {
+		local := dual.v4.LocalAddr().(*net.UDPAddr)
+		if network == "udp6" {
+			local = dual.v6.LocalAddr().(*net.UDPAddr)
+		}
+		remote, err := net.ListenUDP(network, &net.UDPAddr{IP: local.IP})
+		require.NoError(t, err)
+
+		_, err = remote.WriteToUDP([]byte("ping "+network), local)
+		require.NoError(t, err)
+		buf := make([]byte, maxPacketSize)
+		n, from, err := dual.ReadFromUDP(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "ping "+network, string(buf[:n]))
+		assert.Equal(t, remote.LocalAddr().String(), from.String())
+
+		// the answer goes through the socket of the address family
+		_, err = dual.WriteToUDP([]byte("pong"), from)
+		require.NoError(t, err)
+		require.NoError(t, remote.SetReadDeadline(time.Now().Add(time.Second)))
+		n, from, err = remote.ReadFromUDP(buf)
+		require.NoError(t, err)
+		assert.Equal(t, "pong", string(buf[:n]))
+		assert.Equal(t, local.String(), from.String())
+		remote.Close()
+	}
+
+	require.NoError(t, dual.Close())
+	_, _, err = dual.ReadFromUDP(make([]byte, maxPacketSize))
+	assert.ErrorIs(t, err, net.ErrClosed)
+}
+
+func TestDualConnWildcard(t *testing.T) {
+	// the port of a closed socket, both sockets bind it
+	free, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4zero})
+	require.NoError(t, err)
+	port := free.LocalAddr().(*net.UDPAddr).Port
+	require.NoError(t, free.Close())
+
+	conn, err := listen(&listenConfig{listenAddress: net.IPv4zero, listenAddress6: net.IPv6unspecified, listenPORT: port})
+	if err != nil && errors.Is(err, syscall.EADDRNOTAVAIL) {
+		t.Skipf("no IPv6 socket: %v", err)
+	}
+	require.NoError(t, err)
+	dual, ok := conn.(*dualConn)
+	require.True(t, ok)
+	defer dual.Close()
+	assert.Equal(t, port, dual.v4.LocalAddr().(*net.UDPAddr).Port)
+	assert.Equal(t, port, dual.v6.LocalAddr().(*net.UDPAddr).Port)
+}
@@ -0,0 +1,242 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package gossip holds the gossipsub observer measuring message propagation
+package gossip
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"eth2-crawler/crawler/network"
+	"eth2-crawler/models"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+const (
+	blockTopic     = "beacon_block"
+	aggregateTopic = "beacon_aggregate_and_proof"
+)
+
+var (
+	messageDomainValidSnappy   = [4]byte{0x01, 0x00, 0x00, 0x00}
+	messageDomainInvalidSnappy = [4]byte{0x00, 0x00, 0x00, 0x00}
+)
+
+// Observer joins the block and aggregate topics of the current fork without validating
+// or forwarding any message. It records the peer delivering every message first.
+type Observer struct {
+	ps      *pubsub.PubSub
+	network *network.Network
+
+	digest common.ForkDigest
+	topics []*pubsub.Topic
+	subs   []*pubsub.Subscription
+
+	mu    sync.Mutex
+	stats map[peer.ID]*models.Propagation
+}
+
+// NewObserver starts gossipsub on the host
+func NewObserver(ctx context.Context, h host.Host, eth2Network *network.Network) (*Observer, error) {
+	ps, err := pubsub.NewGossipSub(ctx, h,
+		pubsub.WithMessageIdFn(messageID),
+		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
+		pubsub.WithNoAuthor(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Observer{
+		ps:      ps,
+		network: eth2Network,
+		stats:   make(map[peer.ID]*models.Propagation),
+	}, nil
+}
+
+// Start joins the topics of the current fork and moves to the next fork topics once it activates
+func (o *Observer) Start(ctx context.Context) {
+	ticker := time.NewTicker(o.network.SlotDuration() * network.SlotsPerEpoch)
+	defer ticker.Stop()
+	for {
+		if digest := o.network.CurrentDigest(); digest != o.digest || o.topics == nil {
+			if err := o.join(digest); err != nil {
+				log.Error("unable to join gossip topics", log.Ctx{"err": err, "digest": digest})
+			}
+		}
+		select {
+		case <-ctx.Done():
+			o.leave()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Collect returns the propagation stats gathered since the last call
+func (o *Observer) Collect() map[peer.ID]*models.Propagation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	stats := o.stats
+	o.stats = make(map[peer.ID]*models.Propagation)
+	return stats
+}
+
+func (o *Observer) join(digest common.ForkDigest) error {
+	o.leave()
+	o.digest = digest
+	for _, name := range []string{blockTopic, aggregateTopic} {
+		name := name
+		topic := fmt.Sprintf("/eth2/%s/%s/ssz_snappy", hex.EncodeToString(digest[:]), name)
+		// messages are only recorded, ignoring them keeps them from being delivered or forwarded
+		err := o.ps.RegisterTopicValidator(topic, func(ctx context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+			o.record(name, msg.ReceivedFrom, msg.Data, time.Now())
+			return pubsub.ValidationIgnore
+		})
+		if err != nil {
+			return err
+		}
+		t, err := o.ps.Join(topic)
+		if err != nil {
+			return err
+		}
+		sub, err := t.Subscribe()
+		if err != nil {
+			return err
+		}
+		o.topics = append(o.topics, t)
+		o.subs = append(o.subs, sub)
+		go drain(sub)
+	}
+	return nil
+}
+
+func (o *Observer) leave() {
+	for _, sub := range o.subs {
+		sub.Cancel()
+	}
+	for _, t := range o.topics {
+		_ = o.ps.UnregisterTopicValidator(t.String())
+		_ = t.Close()
+	}
+	o.subs = nil
+	o.topics = nil
+}
+
+// drain consumes the subscription, no message gets through the validator
+func drain(sub *pubsub.Subscription) {
+	for {
+		if _, err := sub.Next(context.Background()); err != nil {
+			return
+		}
+	}
+}
+
+func (o *Observer) record(topic string, from peer.ID, data []byte, arrival time.Time) {
+	decoded, err := snappy.Decode(nil, data)
+	if err != nil {
+		return
+	}
+	var slot common.Slot
+	switch topic {
+	case blockTopic:
+		slot, err = blockSlot(decoded)
+	case aggregateTopic:
+		slot, err = aggregateSlot(decoded)
+	}
+	if err != nil {
+		log.Debug("invalid gossip message", log.Ctx{"peer_id": from, "topic": topic, "err": err})
+		return
+	}
+	slotStart := o.network.GenesisTime.Add(time.Duration(slot) * o.network.SlotDuration())
+	latency := arrival.Sub(slotStart).Milliseconds()
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	stats, ok := o.stats[from]
+	if !ok {
+		stats = new(models.Propagation)
+		o.stats[from] = stats
+	}
+	switch topic {
+	case blockTopic:
+		stats.Blocks++
+		stats.BlockLatency += latency
+	case aggregateTopic:
+		stats.Aggregates++
+		stats.AggregateLatency += latency
+	}
+}
+
+// messageID computes the altair message id of eth2 gossip messages
+func messageID(pmsg *pb.Message) string {
+	topic := pmsg.GetTopic()
+	topicLen := make([]byte, 8)
+	binary.LittleEndian.PutUint64(topicLen, uint64(len(topic)))
+
+	h := sha256.New()
+	if decoded, err := snappy.Decode(nil, pmsg.Data); err == nil {
+		h.Write(messageDomainValidSnappy[:])
+		h.Write(topicLen)
+		h.Write([]byte(topic))
+		h.Write(decoded)
+	} else {
+		h.Write(messageDomainInvalidSnappy[:])
+		h.Write(topicLen)
+		h.Write([]byte(topic))
+		h.Write(pmsg.Data)
+	}
+	return string(h.Sum(nil)[:20])
+}
+
+// blockSlot reads the slot of a SignedBeaconBlock of any fork: the offset of the block
+// and the signature come first, the block starts with its slot
+func blockSlot(data []byte) (common.Slot, error) {
+	offset, err := readOffset(data, 0)
+	if err != nil {
+		return 0, err
+	}
+	return readSlot(data, offset)
+}
+
+// aggregateSlot reads the slot of a SignedAggregateAndProof of any fork,
+// it is the first field of the attestation data following the aggregation bits offset
+func aggregateSlot(data []byte) (common.Slot, error) {
+	message, err := readOffset(data, 0)
+	if err != nil {
+		return 0, err
+	}
+	// AggregateAndProof starts with the aggregator index, followed by the aggregate offset
+	aggregate, err := readOffset(data, message+8)
+	if err != nil {
+		return 0, err
+	}
+	return readSlot(data, message+aggregate+4)
+}
+
+func readOffset(data []byte, i uint64) (uint64, error) {
+	if uint64(len(data)) < i+4 {
+		return 0, errors.New("message too short")
+	}
+	return uint64(binary.LittleEndian.Uint32(data[i:])), nil
+}
+
+func readSlot(data []byte, i uint64) (common.Slot, error) {
+	if uint64(len(data)) < i+8 {
+		return 0, errors.New("message too short")
+	}
+	return common.Slot(binary.LittleEndian.Uint64(data[i:])), nil
+}
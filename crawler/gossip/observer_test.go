@@ -0,0 +1,115 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package gossip
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"eth2-crawler/crawler/network"
+	"eth2-crawler/models"
+
+	"github.com/golang/snappy"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// signedBlock encodes the head of a SignedBeaconBlock: the block offset, the signature and the slot
+func signedBlock(slot common.Slot) []byte {
+	data := make([]byte, 4+96+8)
+	binary.LittleEndian.PutUint32(data, 100)
+	binary.LittleEndian.PutUint64(data[100:], uint64(slot))
+	return data
+}
+
+// signedAggregate encodes the head of a SignedAggregateAndProof up to the attestation slot
+func signedAggregate(slot common.Slot) []byte {
+	data := make([]byte, 4+96+8+4+96+4+8)
+	binary.LittleEndian.PutUint32(data, 100)
+	// the aggregate follows the aggregator index, its offset and the selection proof
+	binary.LittleEndian.PutUint32(data[108:], 108)
+	binary.LittleEndian.PutUint64(data[100+108+4:], uint64(slot))
+	return data
+}
+
+func TestMessageSlot(t *testing.T) {
+	slot, err := blockSlot(signedBlock(1234))
+	require.NoError(t, err)
+	assert.Equal(t, common.Slot(1234), slot)
+
+	slot, err = aggregateSlot(signedAggregate(5678))
+	require.NoError(t, err)
+	assert.Equal(t, common.Slot(5678), slot)
+}
+
+func TestMessageSlotInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		slot func([]byte) (common.Slot, error)
+	}{
+		{name: "empty block", data: nil, slot: blockSlot},
+		{name: "truncated block", data: signedBlock(1)[:104], slot: blockSlot},
+		{name: "block offset out of range", data: func() []byte {
+			data := signedBlock(1)
+			binary.LittleEndian.PutUint32(data, 1000)
+			return data
+		}(), slot: blockSlot},
+		{name: "truncated aggregate", data: signedAggregate(1)[:110], slot: aggregateSlot},
+		{name: "aggregate offset out of range", data: func() []byte {
+			data := signedAggregate(1)
+			binary.LittleEndian.PutUint32(data[108:], 1<<31)
+			return data
+		}(), slot: aggregateSlot},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.slot(tt.data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	genesis := time.Unix(1606824023, 0)
+	o := &Observer{
+		network: &network.Network{GenesisTime: genesis, SecondsPerSlot: 12},
+		stats:   make(map[peer.ID]*models.Propagation),
+	}
+	slotStart := genesis.Add(100 * 12 * time.Second)
+
+	o.record(blockTopic, "a", snappy.Encode(nil, signedBlock(100)), slotStart.Add(400*time.Millisecond))
+	o.record(blockTopic, "a", snappy.Encode(nil, signedBlock(101)), slotStart.Add(12*time.Second+600*time.Millisecond))
+	o.record(aggregateTopic, "a", snappy.Encode(nil, signedAggregate(100)), slotStart.Add(8*time.Second))
+	o.record(aggregateTopic, "b", snappy.Encode(nil, signedAggregate(100)), slotStart.Add(9*time.Second))
+	// invalid messages are not counted
+	o.record(blockTopic, "b", snappy.Encode(nil, []byte{1, 2}), slotStart)
+
+	stats := o.Collect()
+	assert.Equal(t, map[peer.ID]*models.Propagation{
+		"a": {Blocks: 2, BlockLatency: 1000, Aggregates: 1, AggregateLatency: 8000},
+		"b": {Aggregates: 1, AggregateLatency: 9000},
+	}, stats)
+	assert.Empty(t, o.Collect())
+}
+
+func TestMessageID(t *testing.T) {
+	topic := "/eth2/4a26c58b/beacon_block/ssz_snappy"
+	data := signedBlock(1)
+	valid := messageID(&pb.Message{Topic: &topic, Data: snappy.Encode(nil, data)})
+	assert.Len(t, valid, 20)
+	// the id is computed over the decompressed payload
+	assert.Equal(t, valid, messageID(&pb.Message{Topic: &topic, Data: snappy.Encode(nil, data)}))
+
+	invalid := messageID(&pb.Message{Topic: &topic, Data: data})
+	assert.Len(t, invalid, 20)
+	assert.NotEqual(t, valid, invalid)
+
+	other := "/eth2/4a26c58b/beacon_aggregate_and_proof/ssz_snappy"
+	assert.NotEqual(t, valid, messageID(&pb.Message{Topic: &other, Data: snappy.Encode(nil, data)}))
+}
@@ -0,0 +1,480 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package network holds the eth2 network profiles the crawler can run against
+package network
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"eth2-crawler/crawler/util"
+	"eth2-crawler/utils/config"
+
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+const (
+	Mainnet = "mainnet"
+	Prater  = "prater"
+	Sepolia = "sepolia"
+)
+
+// fork names of the built-in fork schedules
+const (
+	Phase0    = "phase0"
+	Altair    = "altair"
+	Bellatrix = "bellatrix"
+	Capella   = "capella"
+	Deneb     = "deneb"
+	Electra   = "electra"
+	Fulu      = "fulu"
+
+	// UnknownFork labels digests and versions that are not part of the fork schedule
+	UnknownFork = "unknown"
+)
+
+// SlotsPerEpoch is the same on all the supported networks
+const SlotsPerEpoch = 32
+
+// MaxBlobsPerBlockElectra is the blob limit before the first entry of the blob schedule
+const MaxBlobsPerBlockElectra = 9
+
+// Fork represents an entry of the fork schedule
+type Fork struct {
+	Name    string
+	Version common.Version
+	Epoch   common.Epoch
+	Digest  common.ForkDigest
+}
+
+// BlobParameters is an entry of the blob schedule.
+// From Fulu on, the parameters in effect are mixed into the fork digest.
+type BlobParameters struct {
+	Epoch            common.Epoch
+	MaxBlobsPerBlock uint64
+}
+
+// scheduledDigest is a fork digest of the schedule and the epoch it starts at
+type scheduledDigest struct {
+	fork   *Fork
+	epoch  common.Epoch
+	digest common.ForkDigest
+}
+
+// Network holds everything the crawler needs to know about an eth2 network
+type Network struct {
+	Name                  string
+	GenesisTime           time.Time
+	GenesisValidatorsRoot common.Root
+	SecondsPerSlot        int64
+	Forks                 []*Fork
+	// BlobSchedule is ordered by epoch, every entry from Fulu on starts a new fork digest
+	BlobSchedule []*BlobParameters
+	Bootnodes    []string
+	// Head is the configured checkpoint announced until the head of the peers is known, nil if none
+	Head *common.Status
+
+	// digests holds every digest of the schedule, ordered by epoch
+	digests []*scheduledDigest
+}
+
+// praterBootnodes are the consensus layer bootnodes of eth-clients/goerli
+var praterBootnodes = []string{
+	// q9f errai
+	"enr:-LK4QH1xnjotgXwg25IDPjrqRGFnH1ScgNHA3dv1Z8xHCp4uP3N3Jjl_aYv_WIxQRdwZvSukzbwspXZ7JjpldyeVDzMCh2F0dG5ldHOIAAAAAAAAAACEZXRoMpB53wQoAAAQIP__________gmlkgnY0gmlwhIe1te-Jc2VjcDI1NmsxoQOkcGXqbCJYbcClZ3z5f6NWhX_1YPFRYRRWQpJjwSHpVIN0Y3CCIyiDdWRwgiMo",
+	// q9f gudja
+	"enr:-KG4QCIzJZTY_fs_2vqWEatJL9RrtnPwDCv-jRBuO5FQ2qBrfJubWOWazri6s9HsyZdu-fRUfEzkebhf1nvO42_FVzwDhGV0aDKQed8EKAAAECD__________4JpZIJ2NIJpcISHtbYziXNlY3AyNTZrMaED4m9AqVs6F32rSCGsjtYcsyfQE2K8nDiGmocUY_iq-TSDdGNwgiMog3VkcIIjKA",
+}
+
+// sepoliaBootnodes are the consensus layer bootnodes of eth-clients/sepolia
+var sepoliaBootnodes = []string{
+	// Lodestar
+	"enr:-KG4QE5OIg5ThTjkzrlVF32WT_-XT14WeJtIz2zoTqLLjQhYAmJlnk4ItSoH41_2x0RX0wTFIe5GgjRzU2u7Q1fN4vADhGV0aDKQqP7o7pAAAHAyAAAAAAAAAIJpZIJ2NIJpcISlFsStiXNlY3AyNTZrMaEC-Rrd_bBZwhKpXzFCrStKp1q_HmGOewxY3KwM8ofAj_ODdGNwgiMog3VkcIIjKA",
+	// Teku
+	"enr:-L64QC9Hhov4DhQ7mRukTOz4_jHm4DHlGL726NWH4ojH1wFgEwSin_6H95Gs6nW2fktTWbPachHJ6rUFu0iJNgA0SB2CARqHYXR0bmV0c4j__________4RldGgykDb6UBOQAABx__________-CaWSCdjSCaXCEA-2vzolzZWNwMjU2azGhA17lsUg60R776rauYMdrAz383UUgESoaHEzMkvm4K6k6iHN5bmNuZXRzD4N0Y3CCIyiDdWRwgiMo",
+}
+
+// profiles contains the built-in network profiles
+var profiles map[string]*Network
+
+func init() {
+	profiles = map[string]*Network{
+		Mainnet: {
+			Name:                  Mainnet,
+			GenesisTime:           time.Unix(1606824023, 0),
+			GenesisValidatorsRoot: mustRoot("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+			SecondsPerSlot:        12,
+			Forks: []*Fork{
+				{Name: Phase0, Version: common.Version{0x00, 0x00, 0x00, 0x00}, Epoch: 0},
+				{Name: Altair, Version: common.Version{0x01, 0x00, 0x00, 0x00}, Epoch: 74240},
+				{Name: Bellatrix, Version: common.Version{0x02, 0x00, 0x00, 0x00}, Epoch: 144896},
+				{Name: Capella, Version: common.Version{0x03, 0x00, 0x00, 0x00}, Epoch: 194048},
+				{Name: Deneb, Version: common.Version{0x04, 0x00, 0x00, 0x00}, Epoch: 269568},
+				{Name: Electra, Version: common.Version{0x05, 0x00, 0x00, 0x00}, Epoch: 364032},
+				{Name: Fulu, Version: common.Version{0x06, 0x00, 0x00, 0x00}, Epoch: 411392},
+			},
+			BlobSchedule: []*BlobParameters{
+				{Epoch: 412672, MaxBlobsPerBlock: 15},
+				{Epoch: 419072, MaxBlobsPerBlock: 21},
+			},
+			Bootnodes: params.V5Bootnodes,
+		},
+		Prater: {
+			Name:                  Prater,
+			GenesisTime:           time.Unix(1616508000, 0),
+			GenesisValidatorsRoot: mustRoot("0x043db0d9a83813551ee2f33450d23797757d430911a9320530ad8a0eabc43efb"),
+			SecondsPerSlot:        12,
+			Forks: []*Fork{
+				{Name: Phase0, Version: common.Version{0x00, 0x00, 0x10, 0x20}, Epoch: 0},
+				{Name: Altair, Version: common.Version{0x01, 0x00, 0x10, 0x20}, Epoch: 36660},
+				{Name: Bellatrix, Version: common.Version{0x02, 0x00, 0x10, 0x20}, Epoch: 112260},
+				{Name: Capella, Version: common.Version{0x03, 0x00, 0x10, 0x20}, Epoch: 162304},
+				{Name: Deneb, Version: common.Version{0x04, 0x00, 0x10, 0x20}, Epoch: 231680},
+			},
+			Bootnodes: praterBootnodes,
+		},
+		Sepolia: {
+			Name:                  Sepolia,
+			GenesisTime:           time.Unix(1655733600, 0),
+			GenesisValidatorsRoot: mustRoot("0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078"),
+			SecondsPerSlot:        12,
+			Forks: []*Fork{
+				{Name: Phase0, Version: common.Version{0x90, 0x00, 0x00, 0x69}, Epoch: 0},
+				{Name: Altair, Version: common.Version{0x90, 0x00, 0x00, 0x70}, Epoch: 50},
+				{Name: Bellatrix, Version: common.Version{0x90, 0x00, 0x00, 0x71}, Epoch: 100},
+				{Name: Capella, Version: common.Version{0x90, 0x00, 0x00, 0x72}, Epoch: 56832},
+				{Name: Deneb, Version: common.Version{0x90, 0x00, 0x00, 0x73}, Epoch: 132608},
+				{Name: Electra, Version: common.Version{0x90, 0x00, 0x00, 0x74}, Epoch: 222464},
+				{Name: Fulu, Version: common.Version{0x90, 0x00, 0x00, 0x75}, Epoch: 272640},
+			},
+			BlobSchedule: []*BlobParameters{
+				{Epoch: 274176, MaxBlobsPerBlock: 15},
+				{Epoch: 275712, MaxBlobsPerBlock: 21},
+			},
+			Bootnodes: sepoliaBootnodes,
+		},
+	}
+}
+
+// New returns the network profile selected by the config.
+// Fields set in the config override the ones of the built-in profile.
+func New(cfg *config.Network) (*Network, error) {
+	if cfg == nil || cfg.Name == "" {
+		return nil, errors.New("network name is required")
+	}
+	n := &Network{Name: cfg.Name}
+	if profile, ok := profiles[strings.ToLower(cfg.Name)]; ok {
+		*n = *profile
+	}
+
+	if cfg.GenesisTime != 0 {
+		n.GenesisTime = time.Unix(cfg.GenesisTime, 0)
+	}
+	if cfg.GenesisValidatorsRoot != "" {
+		root, err := parseRoot(cfg.GenesisValidatorsRoot)
+		if err != nil {
+			return nil, fmt.Errorf("invalid genesis validators root: %w", err)
+		}
+		n.GenesisValidatorsRoot = root
+	}
+	if cfg.SecondsPerSlot != 0 {
+		n.SecondsPerSlot = int64(cfg.SecondsPerSlot)
+	}
+	if len(cfg.Forks) != 0 {
+		forks := make([]*Fork, 0, len(cfg.Forks))
+		for _, f := range cfg.Forks {
+			version, err := parseVersion(f.Version)
+			if err != nil {
+				return nil, fmt.Errorf("invalid version of fork %s: %w", f.Name, err)
+			}
+			forks = append(forks, &Fork{Name: f.Name, Version: version, Epoch: common.Epoch(f.Epoch)})
+		}
+		n.Forks = forks
+	}
+	if len(cfg.BlobSchedule) != 0 {
+		schedule := make([]*BlobParameters, 0, len(cfg.BlobSchedule))
+		for _, b := range cfg.BlobSchedule {
+			schedule = append(schedule, &BlobParameters{Epoch: common.Epoch(b.Epoch), MaxBlobsPerBlock: b.MaxBlobsPerBlock})
+		}
+		n.BlobSchedule = schedule
+	}
+	if len(cfg.Bootnodes) != 0 {
+		n.Bootnodes = cfg.Bootnodes
+	}
+
+	if cfg.Head != nil {
+		head, err := parseHead(cfg.Head)
+		if err != nil {
+			return nil, fmt.Errorf("invalid head: %w", err)
+		}
+		n.Head = head
+	}
+
+	if err := n.validate(); err != nil {
+		return nil, fmt.Errorf("invalid network %s: %w", n.Name, err)
+	}
+	n.computeDigests()
+	return n, nil
+}
+
+// Profile returns the built-in profile of the named network
+func Profile(name string) (*Network, error) {
+	if _, ok := profiles[strings.ToLower(name)]; !ok {
+		return nil, fmt.Errorf("unknown network %s", name)
+	}
+	return New(&config.Network{Name: name})
+}
+
+func (n *Network) validate() error {
+	if n.GenesisTime.IsZero() || n.GenesisTime.Unix() == 0 {
+		return errors.New("genesis time is required")
+	}
+	if n.SecondsPerSlot <= 0 {
+		return errors.New("seconds per slot must be positive")
+	}
+	if len(n.Forks) == 0 {
+		return errors.New("fork schedule is required")
+	}
+	for i := 1; i < len(n.Forks); i++ {
+		if n.Forks[i].Epoch < n.Forks[i-1].Epoch {
+			return errors.New("fork schedule must be ordered by epoch")
+		}
+	}
+	for i := 1; i < len(n.BlobSchedule); i++ {
+		if n.BlobSchedule[i].Epoch <= n.BlobSchedule[i-1].Epoch {
+			return errors.New("blob schedule must be ordered by epoch")
+		}
+	}
+	if len(n.Bootnodes) == 0 {
+		return errors.New("bootnodes are required")
+	}
+	return nil
+}
+
+// computeDigests fills the fork digest of every fork of the schedule, and the digests of the
+// blob schedule entries activating during a fork from Fulu on.
+// Forks are copied so that the built-in profiles are never modified.
+func (n *Network) computeDigests() {
+	forks := make([]*Fork, 0, len(n.Forks))
+	for _, f := range n.Forks {
+		fork := *f
+		forks = append(forks, &fork)
+	}
+	n.Forks = forks
+
+	n.digests = nil
+	for i, f := range n.Forks {
+		f.Digest = n.ForkDigestAt(f.Epoch)
+		n.digests = append(n.digests, &scheduledDigest{fork: f, epoch: f.Epoch, digest: f.Digest})
+		if !n.mixesBlobParameters(f.Epoch) {
+			continue
+		}
+		for _, b := range n.BlobSchedule {
+			if b.Epoch <= f.Epoch || (i+1 < len(n.Forks) && b.Epoch >= n.Forks[i+1].Epoch) {
+				continue
+			}
+			n.digests = append(n.digests, &scheduledDigest{fork: f, epoch: b.Epoch, digest: n.ForkDigestAt(b.Epoch)})
+		}
+	}
+}
+
+// ForkDigestAt returns the fork digest in effect at the epoch
+func (n *Network) ForkDigestAt(epoch common.Epoch) common.ForkDigest {
+	digest := ComputeForkDigest(n.forkAt(epoch).Version, n.GenesisValidatorsRoot)
+	if !n.mixesBlobParameters(epoch) {
+		return digest
+	}
+	return MixBlobParameters(digest, n.blobParametersAt(epoch))
+}
+
+// mixesBlobParameters reports whether the digest at the epoch includes the blob parameters, from Fulu on
+func (n *Network) mixesBlobParameters(epoch common.Epoch) bool {
+	i := n.ForkIndex(Fulu)
+	return i >= 0 && epoch >= n.Forks[i].Epoch
+}
+
+// blobParametersAt returns the entry of the blob schedule in effect at the epoch,
+// the Electra parameters before the first entry
+func (n *Network) blobParametersAt(epoch common.Epoch) BlobParameters {
+	for i := len(n.BlobSchedule) - 1; i >= 0; i-- {
+		if n.BlobSchedule[i].Epoch <= epoch {
+			return *n.BlobSchedule[i]
+		}
+	}
+	params := BlobParameters{MaxBlobsPerBlock: MaxBlobsPerBlockElectra}
+	if i := n.ForkIndex(Electra); i >= 0 {
+		params.Epoch = n.Forks[i].Epoch
+	}
+	return params
+}
+
+// MixBlobParameters xors the digest with the hash of the blob parameters
+func MixBlobParameters(digest common.ForkDigest, params BlobParameters) common.ForkDigest {
+	var data [16]byte
+	binary.LittleEndian.PutUint64(data[:8], uint64(params.Epoch))
+	binary.LittleEndian.PutUint64(data[8:], params.MaxBlobsPerBlock)
+	mask := sha256.Sum256(data[:])
+	for i := range digest {
+		digest[i] ^= mask[i]
+	}
+	return digest
+}
+
+// ComputeForkDigest returns the first 4 bytes of the hash tree root of the ForkData container
+func ComputeForkDigest(version common.Version, genesisValidatorsRoot common.Root) common.ForkDigest {
+	// ForkData has two fields, so its root is the hash of both 32 bytes chunks
+	var chunks [64]byte
+	copy(chunks[:4], version[:])
+	copy(chunks[32:], genesisValidatorsRoot[:])
+	root := sha256.Sum256(chunks[:])
+
+	var digest common.ForkDigest
+	copy(digest[:], root[:4])
+	return digest
+}
+
+// ForkByDigest returns the fork of the schedule matching the digest,
+// including the digests of the blob schedule entries
+func (n *Network) ForkByDigest(digest common.ForkDigest) (*Fork, bool) {
+	for _, d := range n.digests {
+		if d.digest == digest {
+			return d.fork, true
+		}
+	}
+	return nil, false
+}
+
+// ForkByVersion returns the fork of the schedule matching the version
+func (n *Network) ForkByVersion(version common.Version) (*Fork, bool) {
+	for _, f := range n.Forks {
+		if f.Version == version {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// ForkIndex returns the position of the named fork in the schedule, -1 if not scheduled
+func (n *Network) ForkIndex(name string) int {
+	for i, f := range n.Forks {
+		if f.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// ClassifyFork returns the current fork and the announced next fork of the ENR eth2 data.
+// The next fork is empty when the peer doesn't announce any.
+func (n *Network) ClassifyFork(eth2Data *common.Eth2Data) (current string, next string) {
+	current = UnknownFork
+	if f, ok := n.ForkByDigest(eth2Data.ForkDigest); ok {
+		current = f.Name
+	}
+	if eth2Data.NextForkEpoch == common.FAR_FUTURE_EPOCH {
+		return current, ""
+	}
+	next = UnknownFork
+	if f, ok := n.ForkByVersion(eth2Data.NextForkVersion); ok {
+		next = f.Name
+	}
+	return current, next
+}
+
+// CurrentFork returns the fork of the schedule active at the wall-clock epoch
+func (n *Network) CurrentFork() *Fork {
+	return n.forkAt(n.CurrentEpoch())
+}
+
+// CurrentDigest returns the fork digest in effect at the wall-clock epoch
+func (n *Network) CurrentDigest() common.ForkDigest {
+	return n.ForkDigestAt(n.CurrentEpoch())
+}
+
+// CurrentEpoch returns the wall-clock epoch of the network, 0 before genesis
+func (n *Network) CurrentEpoch() common.Epoch {
+	slot := n.CurrentSlot()
+	if slot < 0 { // before genesis
+		slot = 0
+	}
+	return common.Epoch(slot / SlotsPerEpoch)
+}
+
+// forkAt returns the fork of the schedule active at the epoch
+func (n *Network) forkAt(epoch common.Epoch) *Fork {
+	current := n.Forks[0]
+	for _, f := range n.Forks {
+		if f.Epoch <= epoch {
+			current = f
+		}
+	}
+	return current
+}
+
+// SlotDuration returns the duration of a single slot
+func (n *Network) SlotDuration() time.Duration {
+	return time.Duration(n.SecondsPerSlot) * time.Second
+}
+
+// CurrentSlot returns the wall-clock slot of the network
+func (n *Network) CurrentSlot() int64 {
+	return util.CurrentSlot(n.GenesisTime, n.SlotDuration())
+}
+
+func mustRoot(s string) common.Root {
+	root, err := parseRoot(s)
+	if err != nil {
+		panic(err)
+	}
+	return root
+}
+
+func parseRoot(s string) (common.Root, error) {
+	var root common.Root
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return root, err
+	}
+	if len(b) != len(root) {
+		return root, fmt.Errorf("expected %d bytes, got %d", len(root), len(b))
+	}
+	copy(root[:], b)
+	return root, nil
+}
+
+func parseHead(cfg *config.Head) (*common.Status, error) {
+	finalizedRoot, err := parseRoot(cfg.FinalizedRoot)
+	if err != nil {
+		return nil, fmt.Errorf("finalized root: %w", err)
+	}
+	headRoot, err := parseRoot(cfg.HeadRoot)
+	if err != nil {
+		return nil, fmt.Errorf("head root: %w", err)
+	}
+	return &common.Status{
+		FinalizedRoot:  finalizedRoot,
+		FinalizedEpoch: common.Epoch(cfg.FinalizedEpoch),
+		HeadRoot:       headRoot,
+		HeadSlot:       common.Slot(cfg.HeadSlot),
+	}, nil
+}
+
+func parseVersion(s string) (common.Version, error) {
+	var version common.Version
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return version, err
+	}
+	if len(b) != len(version) {
+		return version, fmt.Errorf("expected %d bytes, got %d", len(version), len(b))
+	}
+	copy(version[:], b)
+	return version, nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package network
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"eth2-crawler/crawler/util"
+	"eth2-crawler/utils/config"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProfile(t *testing.T) {
+	n, err := New(&config.Network{Name: "Prater"})
+	require.NoError(t, err)
+	assert.Equal(t, Prater, n.Name)
+	assert.Equal(t, int64(1616508000), n.GenesisTime.Unix())
+	assert.NotEmpty(t, n.Bootnodes)
+}
+
+func TestNewOverride(t *testing.T) {
+	n, err := New(&config.Network{
+		Name:      Mainnet,
+		Bootnodes: []string{"enr:custom"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"enr:custom"}, n.Bootnodes)
+	require.Len(t, n.Forks, len(profiles[Mainnet].Forks))
+	for i, f := range profiles[Mainnet].Forks {
+		assert.Equal(t, f.Name, n.Forks[i].Name)
+		assert.Equal(t, f.Version, n.Forks[i].Version)
+		assert.Equal(t, f.Epoch, n.Forks[i].Epoch)
+	}
+	// the built-in profile is never modified
+	assert.Equal(t, common.ForkDigest{}, profiles[Mainnet].Forks[0].Digest)
+}
+
+func TestBootnodes(t *testing.T) {
+	for _, name := range []string{Prater, Sepolia} {
+		n, err := New(&config.Network{Name: name})
+		require.NoError(t, err)
+		for _, record := range n.Bootnodes {
+			node, err := enode.Parse(enode.ValidSchemes, record)
+			require.NoError(t, err, name)
+			eth2Data, err := util.ParseEnrEth2Data(node)
+			require.NoError(t, err, name)
+			_, ok := n.ForkByDigest(eth2Data.ForkDigest)
+			assert.True(t, ok, "%s bootnode %s is not on the fork schedule", name, node.ID())
+		}
+	}
+}
+
+func TestNewDevnet(t *testing.T) {
+	_, err := New(&config.Network{Name: "devnet"})
+	assert.Error(t, err)
+
+	n, err := New(&config.Network{
+		Name:                  "devnet",
+		GenesisTime:           1650000000,
+		GenesisValidatorsRoot: "0x0000000000000000000000000000000000000000000000000000000000000001",
+		SecondsPerSlot:        6,
+		Forks: []*config.Fork{
+			{Name: "phase0", Version: "0x10000000", Epoch: 0},
+			{Name: "altair", Version: "0x11000000", Epoch: 10},
+		},
+		Bootnodes: []string{"enr:custom"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "devnet", n.Name)
+	assert.Equal(t, byte(1), n.GenesisValidatorsRoot[31])
+	assert.Equal(t, byte(0x11), n.Forks[1].Version[0])
+	assert.Greater(t, n.CurrentSlot(), int64(0))
+}
+
+func TestCurrentFork(t *testing.T) {
+	n, err := New(&config.Network{Name: Mainnet})
+	require.NoError(t, err)
+	assert.Equal(t, n.Forks[len(n.Forks)-1], n.CurrentFork())
+
+	n.GenesisTime = time.Now().Add(time.Hour)
+	assert.Equal(t, n.Forks[0], n.CurrentFork())
+}
+
+func TestComputeForkDigest(t *testing.T) {
+	n, err := New(&config.Network{Name: Mainnet})
+	require.NoError(t, err)
+
+	expected := map[string]string{
+		Phase0:    "b5303f2a",
+		Altair:    "afcaaba0",
+		Bellatrix: "4a26c58b",
+		Capella:   "bba4da96",
+		Deneb:     "6a95a1a9",
+	}
+	for name, digest := range expected {
+		f := n.Forks[n.ForkIndex(name)]
+		assert.Equal(t, digest, hex.EncodeToString(f.Digest[:]), name)
+	}
+}
+
+func TestBlobParameterDigests(t *testing.T) {
+	n, err := New(&config.Network{Name: Mainnet})
+	require.NoError(t, err)
+	electra := n.Forks[n.ForkIndex(Electra)]
+	fulu := n.Forks[n.ForkIndex(Fulu)]
+
+	// the blob parameters are mixed in from Fulu on only
+	assert.Equal(t, ComputeForkDigest(electra.Version, n.GenesisValidatorsRoot), electra.Digest)
+	assert.NotEqual(t, ComputeForkDigest(fulu.Version, n.GenesisValidatorsRoot), fulu.Digest)
+	assert.Equal(t, MixBlobParameters(ComputeForkDigest(fulu.Version, n.GenesisValidatorsRoot),
+		BlobParameters{Epoch: electra.Epoch, MaxBlobsPerBlock: MaxBlobsPerBlockElectra}), fulu.Digest)
+
+	assert.Equal(t, fulu.Digest, n.ForkDigestAt(n.BlobSchedule[0].Epoch-1))
+	seen := map[common.ForkDigest]bool{fulu.Digest: true}
+	for _, b := range n.BlobSchedule {
+		digest := n.ForkDigestAt(b.Epoch)
+		assert.False(t, seen[digest], "digest of epoch %d is not unique", b.Epoch)
+		seen[digest] = true
+
+		f, ok := n.ForkByDigest(digest)
+		require.True(t, ok)
+		assert.Equal(t, Fulu, f.Name)
+	}
+}
+
+func TestClassifyFork(t *testing.T) {
+	n, err := New(&config.Network{Name: Mainnet})
+	require.NoError(t, err)
+
+	current, next := n.ClassifyFork(&common.Eth2Data{
+		ForkDigest:      n.Forks[n.ForkIndex(Altair)].Digest,
+		NextForkVersion: n.Forks[n.ForkIndex(Bellatrix)].Version,
+		NextForkEpoch:   n.Forks[n.ForkIndex(Bellatrix)].Epoch,
+	})
+	assert.Equal(t, Altair, current)
+	assert.Equal(t, Bellatrix, next)
+
+	current, next = n.ClassifyFork(&common.Eth2Data{
+		ForkDigest:      common.ForkDigest{0xde, 0xad, 0xbe, 0xef},
+		NextForkVersion: common.Version{0xde, 0xad, 0xbe, 0xef},
+		NextForkEpoch:   common.FAR_FUTURE_EPOCH,
+	})
+	assert.Equal(t, UnknownFork, current)
+	assert.Equal(t, "", next)
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"eth2-crawler/crawler/rpc/methods"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+const (
+	// goodbyeTTL is the time a goodbye reason is kept for the update of the peer to read it
+	goodbyeTTL = 10 * time.Minute
+	// maxGoodbyes bounds the reasons kept, the oldest one is dropped first
+	maxGoodbyes = 1024
+)
+
+type goodbye struct {
+	reason methods.Goodbye
+	time   time.Time
+}
+
+// goodbyes keeps the last goodbye reason of every peer until it is read or expires.
+// Peers saying goodbye outside of an update, like inbound peers, never have theirs read.
+type goodbyes struct {
+	mu      sync.Mutex
+	reasons map[peer.ID]goodbye
+}
+
+func newGoodbyes() *goodbyes {
+	return &goodbyes{reasons: make(map[peer.ID]goodbye)}
+}
+
+// add records the reason of the peer
+func (g *goodbyes) add(peerID peer.ID, reason methods.Goodbye, now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.reasons[peerID]; !ok && len(g.reasons) >= maxGoodbyes {
+		g.expire(now)
+	}
+	if _, ok := g.reasons[peerID]; !ok && len(g.reasons) >= maxGoodbyes {
+		g.dropOldest()
+	}
+	g.reasons[peerID] = goodbye{reason: reason, time: now}
+}
+
+// take returns the reason of the peer unless it expired, and forgets it
+func (g *goodbyes) take(peerID peer.ID, now time.Time) (methods.Goodbye, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	entry, ok := g.reasons[peerID]
+	delete(g.reasons, peerID)
+	if !ok || now.Sub(entry.time) > goodbyeTTL {
+		return 0, false
+	}
+	return entry.reason, true
+}
+
+func (g *goodbyes) expire(now time.Time) {
+	for id, entry := range g.reasons {
+		if now.Sub(entry.time) > goodbyeTTL {
+			delete(g.reasons, id)
+		}
+	}
+}
+
+func (g *goodbyes) dropOldest() {
+	var oldest peer.ID
+	var oldestTime time.Time
+	for id, entry := range g.reasons {
+		if oldestTime.IsZero() || entry.time.Before(oldestTime) {
+			oldest, oldestTime = id, entry.time
+		}
+	}
+	delete(g.reasons, oldest)
+}
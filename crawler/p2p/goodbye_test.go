@@ -0,0 +1,63 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"eth2-crawler/crawler/rpc/methods"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoodbyesTake(t *testing.T) {
+	g := newGoodbyes()
+	now := time.Now()
+	g.add("a", methods.GoodbyeTooManyPeers, now)
+
+	reason, ok := g.take("a", now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, methods.GoodbyeTooManyPeers, reason)
+
+	// a reason is read once
+	_, ok = g.take("a", now.Add(time.Minute))
+	assert.False(t, ok)
+}
+
+func TestGoodbyesExpire(t *testing.T) {
+	g := newGoodbyes()
+	now := time.Now()
+	g.add("a", methods.GoodbyeFault, now)
+
+	_, ok := g.take("a", now.Add(goodbyeTTL+time.Second))
+	assert.False(t, ok)
+	assert.Empty(t, g.reasons)
+}
+
+func TestGoodbyesBounded(t *testing.T) {
+	g := newGoodbyes()
+	now := time.Now()
+	for i := 0; i < maxGoodbyes; i++ {
+		g.add(peer.ID(fmt.Sprint(i)), methods.GoodbyeClientShutdown, now.Add(time.Duration(i)*time.Millisecond))
+	}
+	// the oldest reason is dropped while none expired
+	g.add("new", methods.GoodbyeBanned, now.Add(time.Second))
+	assert.Len(t, g.reasons, maxGoodbyes)
+	_, ok := g.reasons["0"]
+	assert.False(t, ok)
+
+	// expired reasons are dropped first
+	g.add("later", methods.GoodbyeBanned, now.Add(goodbyeTTL+time.Minute))
+	assert.Len(t, g.reasons, 1)
+	_, ok = g.reasons["later"]
+	assert.True(t, ok)
+}
+
+func TestGoodbyeString(t *testing.T) {
+	assert.Equal(t, "too many peers", methods.GoodbyeTooManyPeers.String())
+	assert.Equal(t, "unknown (7)", methods.Goodbye(7).String())
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package p2p
+
+import (
+	"context"
+	"eth2-crawler/crawler/rpc/methods"
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// the crawler doesn't subscribe to any subnet, so its metadata never changes
+const metadataSeqNumber = 0
+
+const (
+	inboundBufferSize = 100
+	identifyTimeout   = 10 * time.Second
+)
+
+// InboundPeer is a peer that connected to the crawler and sent its status
+type InboundPeer struct {
+	ID     peer.ID
+	Addrs  []ma.Multiaddr
+	Status beacon.Status
+}
+
+// registerHandlers answers the requests peers expect every eth2 node to serve
+func (c *Client) registerHandlers(comp reqresp.Compression) {
+	handlers := map[*reqresp.RPCMethod]reqresp.OnRequestListener{
+		&methods.StatusRPCv1:   c.onStatus,
+		&methods.MetaDataRPCv1: c.onMetadataV1,
+		&methods.MetaDataRPCv2: c.onMetadataV2,
+		&methods.PingRPCv1:     c.onPing,
+		&methods.GoodbyeRPCv1:  c.onGoodbye,
+	}
+	for m, listener := range handlers {
+		c.SetStreamHandler(m.Protocol+protocol.ID("_"+comp.Name()),
+			m.MakeStreamHandler(context.Background, comp, listener))
+	}
+}
+
+// SetStatus sets the status announced to peers
+func (c *Client) SetStatus(status *beacon.Status) {
+	c.statusMu.Lock()
+	defer c.statusMu.Unlock()
+	c.status = status
+}
+
+func (c *Client) localStatus() *beacon.Status {
+	c.statusMu.RLock()
+	defer c.statusMu.RUnlock()
+	return c.status
+}
+
+// InboundPeers returns the peers found through inbound connections
+func (c *Client) InboundPeers() <-chan *InboundPeer {
+	return c.inbound
+}
+
+func (c *Client) onStatus(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	var status beacon.Status
+	if err := handler.ReadRequest(&status); err != nil {
+		_ = handler.WriteErrorChunk(reqresp.InvalidReqCode, "could not read status request")
+		return
+	}
+	c.recordInbound(peerID, &status)
+	local := c.localStatus()
+	if local == nil {
+		_ = handler.WriteErrorChunk(reqresp.ServerErrCode, "status not available yet")
+		return
+	}
+	if err := handler.WriteResponseChunk(reqresp.SuccessCode, local); err != nil {
+		log.Debug("failed to answer status request", log.Ctx{"peer_id": peerID, "err": err})
+	}
+}
+
+func (c *Client) onMetadataV1(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	md := &methods.MetaDataV1{SeqNumber: metadataSeqNumber}
+	if err := handler.WriteResponseChunk(reqresp.SuccessCode, md); err != nil {
+		log.Debug("failed to answer metadata request", log.Ctx{"peer_id": peerID, "err": err})
+	}
+}
+
+func (c *Client) onMetadataV2(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	md := &methods.MetaDataV2{SeqNumber: metadataSeqNumber}
+	if err := handler.WriteResponseChunk(reqresp.SuccessCode, md); err != nil {
+		log.Debug("failed to answer metadata request", log.Ctx{"peer_id": peerID, "err": err})
+	}
+}
+
+func (c *Client) onPing(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	var ping methods.Ping
+	if err := handler.ReadRequest(&ping); err != nil {
+		_ = handler.WriteErrorChunk(reqresp.InvalidReqCode, "could not read ping request")
+		return
+	}
+	pong := methods.Ping(metadataSeqNumber)
+	if err := handler.WriteResponseChunk(reqresp.SuccessCode, &pong); err != nil {
+		log.Debug("failed to answer ping request", log.Ctx{"peer_id": peerID, "err": err})
+	}
+}
+
+// onGoodbye records the reason sent by a peer closing the connection
+func (c *Client) onGoodbye(ctx context.Context, peerID peer.ID, handler reqresp.ChunkedRequestHandler) {
+	var reason methods.Goodbye
+	if err := handler.ReadRequest(&reason); err != nil {
+		log.Debug("invalid goodbye request", log.Ctx{"peer_id": peerID, "err": err})
+		return
+	}
+	log.Debug("received goodbye", log.Ctx{"peer_id": peerID, "reason": reason.String()})
+	c.goodbyes.add(peerID, reason, time.Now())
+}
+
+// recordInbound publishes peers that dialed the crawler. The listen addresses
+// are only known once identify completes, the remote address uses an ephemeral port.
+func (c *Client) recordInbound(peerID peer.ID, status *beacon.Status) {
+	var conn network.Conn
+	for _, v := range c.Network().ConnsToPeer(peerID) {
+		if v.Stat().Direction == network.DirInbound {
+			conn = v
+			break
+		}
+	}
+	if conn == nil {
+		return
+	}
+	go func() {
+		select {
+		case <-c.idSvc.IdentifyWait(conn):
+		case <-time.After(identifyTimeout):
+			return
+		}
+		addrs := make([]ma.Multiaddr, 0)
+		for _, addr := range c.Peerstore().Addrs(peerID) {
+			if manet.IsPublicAddr(addr) {
+				addrs = append(addrs, addr)
+			}
+		}
+		if len(addrs) == 0 {
+			return
+		}
+		select {
+		case c.inbound <- &InboundPeer{ID: peerID, Addrs: addrs, Status: *status}:
+		default:
+			log.Debug("dropping inbound peer, buffer is full", log.Ctx{"peer_id": peerID})
+		}
+	}()
+}
@@ -11,13 +11,16 @@ import (
 	reqresp "eth2-crawler/crawler/rpc/request"
 	"eth2-crawler/models"
 	"fmt"
+	"sync"
+	"time"
 
 	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/view"
 
 	"github.com/libp2p/go-libp2p"
-	"github.com/libp2p/go-libp2p-core/host"
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
 )
 
@@ -25,6 +28,13 @@ import (
 type Client struct {
 	host.Host
 	idSvc idService
+
+	goodbyes *goodbyes
+
+	statusMu sync.RWMutex
+	status   *beacon.Status
+
+	inbound chan *InboundPeer
 }
 
 // Host represent p2p services
@@ -35,6 +45,16 @@ type Host interface {
 	GetAgentVersion(peer.ID) (string, error)
 	FetchStatus(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (
 		*beacon.Status, error)
+	FetchMetadata(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (
+		*methods.MetaDataV2, error)
+	Ping(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (uint64, error)
+	FetchBlocksByRange(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression,
+		startSlot beacon.Slot, count uint64) ([]*methods.SignedBeaconBlock, error)
+	FetchBlocksByRoot(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression,
+		roots []beacon.Root) ([]*methods.SignedBeaconBlock, error)
+	GoodbyeReason(peer.ID) (methods.Goodbye, bool)
+	SetStatus(status *beacon.Status)
+	InboundPeers() <-chan *InboundPeer
 }
 
 type idService interface {
@@ -43,15 +63,28 @@ type idService interface {
 
 // NewHost initializes custom host
 func NewHost(opt ...libp2p.Option) (Host, error) {
-	h, err := libp2p.New(context.Background(), opt...)
+	h, err := libp2p.New(opt...)
 	if err != nil {
 		return nil, err
 	}
-	idService, err := identify.NewIDService(h)
-	if err != nil {
-		return nil, err
+	// the identify service of the host identifies every new connection
+	idHost, ok := h.(interface{ IDService() identify.IDService })
+	if !ok {
+		return nil, errors.New("host has no identify service")
+	}
+	c := &Client{
+		Host:     h,
+		idSvc:    idHost.IDService(),
+		goodbyes: newGoodbyes(),
+		inbound:  make(chan *InboundPeer, inboundBufferSize),
 	}
-	return &Client{Host: h, idSvc: idService}, nil
+	c.registerHandlers(new(reqresp.SnappyCompression))
+	return c, nil
+}
+
+// GoodbyeReason returns the last goodbye reason received from the peer and forgets it
+func (c *Client) GoodbyeReason(peerID peer.ID) (methods.Goodbye, bool) {
+	return c.goodbyes.take(peerID, time.Now())
 }
 
 // IdentifyRequest performs libp2p identify request after connecting to peer.
@@ -116,6 +149,13 @@ func (c *Client) FetchStatus(sFn reqresp.NewStreamFn, ctx context.Context, peer
 		HeadRoot:       beacon.Root{},
 		HeadSlot:       0,
 	}
+	// announce a plausible head once it is known
+	if local := c.localStatus(); local != nil {
+		status.FinalizedRoot = local.FinalizedRoot
+		status.FinalizedEpoch = local.FinalizedEpoch
+		status.HeadRoot = local.HeadRoot
+		status.HeadSlot = local.HeadSlot
+	}
 	resCode := reqresp.ServerErrCode // error by default
 	var data *beacon.Status
 	err := methods.StatusRPCv1.RunRequest(ctx, sFn, peer.ID, comp,
@@ -134,7 +174,7 @@ func (c *Client) FetchStatus(sFn reqresp.NewStreamFn, ctx context.Context, peer
 			case reqresp.SuccessCode:
 				var stat beacon.Status
 				if err := chunk.ReadObj(&stat); err != nil {
-					return err
+					return &DecodeError{Err: err}
 				}
 				data = &stat
 			default:
@@ -144,3 +184,151 @@ func (c *Client) FetchStatus(sFn reqresp.NewStreamFn, ctx context.Context, peer
 		})
 	return data, err
 }
+
+// FetchMetadata requests the peer metadata. It falls back to the phase0 metadata
+// for peers not supporting the v2 method, the sync committee subnets are empty then.
+func (c *Client) FetchMetadata(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (
+	*methods.MetaDataV2, error) {
+	var data *methods.MetaDataV2
+	err := methods.MetaDataRPCv2.RunRequest(ctx, sFn, peer.ID, comp,
+		reqresp.RequestBytesInput([]byte{}), 1,
+		func() error {
+			return nil
+		},
+		func(chunk reqresp.ChunkedResponseHandler) error {
+			return readResponseChunk(chunk, func() error {
+				var md methods.MetaDataV2
+				if err := chunk.ReadObj(&md); err != nil {
+					return err
+				}
+				data = &md
+				return nil
+			})
+		})
+	if err == nil && data != nil {
+		return data, nil
+	}
+
+	err = methods.MetaDataRPCv1.RunRequest(ctx, sFn, peer.ID, comp,
+		reqresp.RequestBytesInput([]byte{}), 1,
+		func() error {
+			return nil
+		},
+		func(chunk reqresp.ChunkedResponseHandler) error {
+			return readResponseChunk(chunk, func() error {
+				var md methods.MetaDataV1
+				if err := chunk.ReadObj(&md); err != nil {
+					return err
+				}
+				data = &methods.MetaDataV2{
+					SeqNumber: md.SeqNumber,
+					Attnets:   md.Attnets,
+				}
+				return nil
+			})
+		})
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("no metadata received")
+	}
+	return data, nil
+}
+
+// Ping sends our metadata sequence number and returns the one of the peer
+func (c *Client) Ping(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression) (uint64, error) {
+	ping := methods.Ping(metadataSeqNumber)
+	var data *methods.Ping
+	err := methods.PingRPCv1.RunRequest(ctx, sFn, peer.ID, comp,
+		reqresp.RequestSSZInput{Obj: &ping}, 1,
+		func() error {
+			return nil
+		},
+		func(chunk reqresp.ChunkedResponseHandler) error {
+			return readResponseChunk(chunk, func() error {
+				var pong methods.Ping
+				if err := chunk.ReadObj(&pong); err != nil {
+					return err
+				}
+				data = &pong
+				return nil
+			})
+		})
+	if err != nil {
+		return 0, err
+	}
+	if data == nil {
+		return 0, errors.New("no pong received")
+	}
+	return uint64(*data), nil
+}
+
+// FetchBlocksByRange requests count blocks starting at startSlot. Empty slots are skipped by the peer.
+func (c *Client) FetchBlocksByRange(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression,
+	startSlot beacon.Slot, count uint64) ([]*methods.SignedBeaconBlock, error) {
+	req := &methods.BlocksByRangeReq{
+		StartSlot: startSlot,
+		Count:     view.Uint64View(count),
+		Step:      1,
+	}
+	return fetchBlocks(&methods.BlocksByRangeRPCv2, sFn, ctx, peer, comp, req, count)
+}
+
+// FetchBlocksByRoot requests the blocks of the given roots
+func (c *Client) FetchBlocksByRoot(sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression,
+	roots []beacon.Root) ([]*methods.SignedBeaconBlock, error) {
+	req := methods.BlocksByRootReq(roots)
+	return fetchBlocks(&methods.BlocksByRootRPCv2, sFn, ctx, peer, comp, &req, uint64(len(roots)))
+}
+
+func fetchBlocks(m *reqresp.RPCMethod, sFn reqresp.NewStreamFn, ctx context.Context, peer *models.Peer, comp reqresp.Compression,
+	req reqresp.SerDes, maxBlocks uint64) ([]*methods.SignedBeaconBlock, error) {
+	blocks := make([]*methods.SignedBeaconBlock, 0)
+	err := m.RunRequest(ctx, sFn, peer.ID, comp,
+		reqresp.RequestSSZInput{Obj: req}, maxBlocks,
+		func() error {
+			return nil
+		},
+		func(chunk reqresp.ChunkedResponseHandler) error {
+			return readResponseChunk(chunk, func() error {
+				var block methods.SignedBeaconBlock
+				if err := chunk.ReadObj(&block); err != nil {
+					return err
+				}
+				blocks = append(blocks, &block)
+				return nil
+			})
+		})
+	return blocks, err
+}
+
+// DecodeError is returned when a response chunk can't be decoded,
+// the stream errors it wraps are still matched by errors.Is
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("error decoding response: %s", e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// readResponseChunk reads the error message of failed chunks and calls onSuccess otherwise
+func readResponseChunk(chunk reqresp.ChunkedResponseHandler, onSuccess func() error) error {
+	switch chunk.ResultCode() {
+	case reqresp.ServerErrCode, reqresp.InvalidReqCode:
+		msg, err := chunk.ReadErrMsg()
+		if err != nil {
+			return fmt.Errorf("%s: %w", msg, err)
+		}
+		return fmt.Errorf("error response: %s", msg)
+	case reqresp.SuccessCode:
+		return onSuccess()
+	default:
+		return errors.New("unexpected result code")
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	"errors"
+	"fmt"
+
+	reqresp "eth2-crawler/crawler/rpc/request"
+
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/view"
+
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+const (
+	// MaxRequestBlocks is the maximum number of blocks of a single request
+	MaxRequestBlocks = 1024
+	// MaxChunkSize is the maximum size of a response chunk
+	MaxChunkSize = 10 << 20
+
+	BlocksByRangeReqByteLen = 8 + 8 + 8
+	// ForkDigestContextLen is the length of the fork digest preceding every block of a response
+	ForkDigestContextLen = 4
+
+	// SignedBeaconBlock is a container of the block offset and the 96 bytes signature,
+	// followed by the block starting with its slot
+	signedBlockMessageOffset = 4 + 96
+	signedBlockMinByteLen    = signedBlockMessageOffset + 8
+)
+
+// BlocksByRangeReq requests count blocks starting at StartSlot
+type BlocksByRangeReq struct {
+	StartSlot beacon.Slot
+	Count     view.Uint64View
+	// Step is deprecated and must be 1
+	Step view.Uint64View
+}
+
+func (r *BlocksByRangeReq) Deserialize(dr *codec.DecodingReader) error {
+	return dr.FixedLenContainer(&r.StartSlot, &r.Count, &r.Step)
+}
+
+func (r *BlocksByRangeReq) Serialize(w *codec.EncodingWriter) error {
+	return w.FixedLenContainer(&r.StartSlot, &r.Count, &r.Step)
+}
+
+func (r *BlocksByRangeReq) ByteLength() uint64 {
+	return BlocksByRangeReqByteLen
+}
+
+func (r *BlocksByRangeReq) FixedLength() uint64 {
+	return BlocksByRangeReqByteLen
+}
+
+// BlocksByRootReq requests blocks by their root
+type BlocksByRootReq []beacon.Root
+
+func (r *BlocksByRootReq) Deserialize(dr *codec.DecodingReader) error {
+	return dr.List(func() codec.Deserializable {
+		i := len(*r)
+		*r = append(*r, beacon.Root{})
+		return &(*r)[i]
+	}, 32, MaxRequestBlocks)
+}
+
+func (r *BlocksByRootReq) Serialize(w *codec.EncodingWriter) error {
+	return w.List(func(i uint64) codec.Serializable {
+		return &(*r)[i]
+	}, 32, uint64(len(*r)))
+}
+
+func (r *BlocksByRootReq) ByteLength() uint64 {
+	return 32 * uint64(len(*r))
+}
+
+func (r *BlocksByRootReq) FixedLength() uint64 {
+	return 0
+}
+
+// SignedBeaconBlock is a block of any fork. Only the slot is decoded,
+// the crawler doesn't need the content of the blocks.
+type SignedBeaconBlock struct {
+	Slot beacon.Slot
+}
+
+func (b *SignedBeaconBlock) Deserialize(dr *codec.DecodingReader) error {
+	offset, err := dr.ReadOffset()
+	if err != nil {
+		return err
+	}
+	if offset != signedBlockMessageOffset {
+		return fmt.Errorf("unexpected block offset %d", offset)
+	}
+	if _, err := dr.Skip(96); err != nil {
+		return err
+	}
+	if err := b.Slot.Deserialize(dr); err != nil {
+		return err
+	}
+	_, err = dr.Skip(dr.Scope())
+	return err
+}
+
+func (b *SignedBeaconBlock) Serialize(w *codec.EncodingWriter) error {
+	return errors.New("serializing blocks is not supported")
+}
+
+func (b *SignedBeaconBlock) ByteLength() uint64 {
+	return 0
+}
+
+func (b *SignedBeaconBlock) FixedLength() uint64 {
+	return 0
+}
+
+var BlocksByRangeRPCv2 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz",
+	RequestCodec:              reqresp.NewSSZCodec(func() reqresp.SerDes { return new(BlocksByRangeReq) }, BlocksByRangeReqByteLen, BlocksByRangeReqByteLen),
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(SignedBeaconBlock) }, signedBlockMinByteLen, MaxChunkSize),
+	DefaultResponseChunkCount: 20,
+	ResponseContextLen:        ForkDigestContextLen,
+}
+
+var BlocksByRootRPCv2 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/beacon_blocks_by_root/2/ssz",
+	RequestCodec:              reqresp.NewSSZCodec(func() reqresp.SerDes { return new(BlocksByRootReq) }, 0, 32*MaxRequestBlocks),
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(SignedBeaconBlock) }, signedBlockMinByteLen, MaxChunkSize),
+	DefaultResponseChunkCount: 20,
+	ResponseContextLen:        ForkDigestContextLen,
+}
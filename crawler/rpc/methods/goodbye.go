@@ -0,0 +1,76 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	"fmt"
+
+	reqresp "eth2-crawler/crawler/rpc/request"
+
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/view"
+)
+
+const GoodbyeByteLen = 8
+
+// Goodbye holds the reason a peer gives for disconnecting
+type Goodbye view.Uint64View
+
+// reasons defined by the spec, followed by the ones commonly used by clients
+const (
+	GoodbyeClientShutdown        Goodbye = 1
+	GoodbyeIrrelevantNetwork     Goodbye = 2
+	GoodbyeFault                 Goodbye = 3
+	GoodbyeUnableToVerifyNetwork Goodbye = 128
+	GoodbyeTooManyPeers          Goodbye = 129
+	GoodbyeBadScore              Goodbye = 250
+	GoodbyeBanned                Goodbye = 251
+	GoodbyeBannedIP              Goodbye = 252
+)
+
+func (g *Goodbye) Deserialize(dr *codec.DecodingReader) error {
+	return (*view.Uint64View)(g).Deserialize(dr)
+}
+
+func (g *Goodbye) Serialize(w *codec.EncodingWriter) error {
+	return view.Uint64View(*g).Serialize(w)
+}
+
+func (g *Goodbye) ByteLength() uint64 {
+	return GoodbyeByteLen
+}
+
+func (g *Goodbye) FixedLength() uint64 {
+	return GoodbyeByteLen
+}
+
+func (g Goodbye) String() string {
+	switch g {
+	case GoodbyeClientShutdown:
+		return "client shutdown"
+	case GoodbyeIrrelevantNetwork:
+		return "irrelevant network"
+	case GoodbyeFault:
+		return "fault"
+	case GoodbyeUnableToVerifyNetwork:
+		return "unable to verify network"
+	case GoodbyeTooManyPeers:
+		return "too many peers"
+	case GoodbyeBadScore:
+		return "bad score"
+	case GoodbyeBanned:
+		return "banned"
+	case GoodbyeBannedIP:
+		return "banned ip"
+	default:
+		return fmt.Sprintf("unknown (%d)", uint64(g))
+	}
+}
+
+var GoodbyeRPCv1 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/goodbye/1/ssz",
+	RequestCodec:              reqresp.NewSSZCodec(func() reqresp.SerDes { return new(Goodbye) }, GoodbyeByteLen, GoodbyeByteLen),
+	ResponseChunkCodec:        (*reqresp.SSZCodec)(nil), // no response data
+	DefaultResponseChunkCount: 0,
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	reqresp "eth2-crawler/crawler/rpc/request"
+	"eth2-crawler/crawler/util"
+
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/view"
+
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+const (
+	MetaDataV1ByteLen = 8 + 8
+	MetaDataV2ByteLen = 8 + 8 + 1
+)
+
+// MetaDataV1 is the phase0 metadata of a peer
+type MetaDataV1 struct {
+	SeqNumber view.Uint64View
+	Attnets   beacon.AttnetBits
+}
+
+func (m *MetaDataV1) Deserialize(dr *codec.DecodingReader) error {
+	return dr.FixedLenContainer(&m.SeqNumber, &m.Attnets)
+}
+
+func (m *MetaDataV1) Serialize(w *codec.EncodingWriter) error {
+	return w.FixedLenContainer(&m.SeqNumber, &m.Attnets)
+}
+
+func (m *MetaDataV1) ByteLength() uint64 {
+	return MetaDataV1ByteLen
+}
+
+func (m *MetaDataV1) FixedLength() uint64 {
+	return MetaDataV1ByteLen
+}
+
+// MetaDataV2 is the altair metadata of a peer, it adds the sync committee subnets
+type MetaDataV2 struct {
+	SeqNumber view.Uint64View
+	Attnets   beacon.AttnetBits
+	Syncnets  util.SyncnetBits
+}
+
+func (m *MetaDataV2) Deserialize(dr *codec.DecodingReader) error {
+	return dr.FixedLenContainer(&m.SeqNumber, &m.Attnets, &m.Syncnets)
+}
+
+func (m *MetaDataV2) Serialize(w *codec.EncodingWriter) error {
+	return w.FixedLenContainer(&m.SeqNumber, &m.Attnets, &m.Syncnets)
+}
+
+func (m *MetaDataV2) ByteLength() uint64 {
+	return MetaDataV2ByteLen
+}
+
+func (m *MetaDataV2) FixedLength() uint64 {
+	return MetaDataV2ByteLen
+}
+
+var MetaDataRPCv1 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/metadata/1/ssz",
+	RequestCodec:              (*reqresp.SSZCodec)(nil), // no request data, just empty bytes.
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(MetaDataV1) }, MetaDataV1ByteLen, MetaDataV1ByteLen),
+	DefaultResponseChunkCount: 1,
+}
+
+var MetaDataRPCv2 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/metadata/2/ssz",
+	RequestCodec:              (*reqresp.SSZCodec)(nil), // no request data, just empty bytes.
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(MetaDataV2) }, MetaDataV2ByteLen, MetaDataV2ByteLen),
+	DefaultResponseChunkCount: 1,
+}
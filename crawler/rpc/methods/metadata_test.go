@@ -0,0 +1,46 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"eth2-crawler/crawler/util"
+
+	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/protolambda/ztyp/codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetaDataV2Encoding(t *testing.T) {
+	md := &MetaDataV2{
+		SeqNumber: 5,
+		Attnets:   beacon.AttnetBits{0x01, 0, 0, 0, 0, 0, 0, 0x80},
+		Syncnets:  util.SyncnetBits{0x09},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, md.Serialize(codec.NewEncodingWriter(&buf)))
+	assert.Equal(t, "0500000000000000"+"0100000000000080"+"09", hex.EncodeToString(buf.Bytes()))
+	assert.Equal(t, uint64(buf.Len()), md.ByteLength())
+
+	var decoded MetaDataV2
+	require.NoError(t, decoded.Deserialize(codec.NewDecodingReader(bytes.NewReader(buf.Bytes()), uint64(buf.Len()))))
+	assert.Equal(t, *md, decoded)
+	assert.True(t, decoded.Syncnets.IsSet(0))
+	assert.False(t, decoded.Syncnets.IsSet(1))
+	assert.True(t, decoded.Syncnets.IsSet(3))
+}
+
+func TestMetaDataV1Encoding(t *testing.T) {
+	data, err := hex.DecodeString("2a00000000000000" + "ff00000000000000")
+	require.NoError(t, err)
+	var md MetaDataV1
+	require.NoError(t, md.Deserialize(codec.NewDecodingReader(bytes.NewReader(data), uint64(len(data)))))
+	assert.Equal(t, uint64(42), uint64(md.SeqNumber))
+	assert.Equal(t, beacon.AttnetBits{0xff}, md.Attnets)
+	assert.Equal(t, uint64(len(data)), md.ByteLength())
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package methods
+
+import (
+	reqresp "eth2-crawler/crawler/rpc/request"
+
+	"github.com/protolambda/ztyp/codec"
+	"github.com/protolambda/ztyp/view"
+)
+
+const PingByteLen = 8
+
+// Ping holds the metadata sequence number of the sender
+type Ping view.Uint64View
+
+func (p *Ping) Deserialize(dr *codec.DecodingReader) error {
+	return (*view.Uint64View)(p).Deserialize(dr)
+}
+
+func (p *Ping) Serialize(w *codec.EncodingWriter) error {
+	return view.Uint64View(*p).Serialize(w)
+}
+
+func (p *Ping) ByteLength() uint64 {
+	return PingByteLen
+}
+
+func (p *Ping) FixedLength() uint64 {
+	return PingByteLen
+}
+
+var PingRPCv1 = reqresp.RPCMethod{
+	Protocol:                  "/eth2/beacon_chain/req/ping/1/ssz",
+	RequestCodec:              reqresp.NewSSZCodec(func() reqresp.SerDes { return new(Ping) }, PingByteLen, PingByteLen),
+	ResponseChunkCodec:        reqresp.NewSSZCodec(func() reqresp.SerDes { return new(Ping) }, PingByteLen, PingByteLen),
+	DefaultResponseChunkCount: 1,
+}
@@ -8,9 +8,9 @@ import (
 	"context"
 	"io"
 
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
-	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 )
 
 type NewStreamFn func(ctx context.Context, peerId peer.ID, protocolId ...protocol.ID) (network.Stream, error)
@@ -24,12 +24,14 @@ func (newStreamFn NewStreamFn) Request(ctx context.Context, peerID peer.ID, prot
 		_ = stream.Close()
 	}()
 
-	var buf bytes.Buffer
-	if err := EncodeHeaderAndPayload(r, &buf, comp); err != nil {
-		return err
-	}
-	if _, err := stream.Write(buf.Bytes()); err != nil {
-		return err
+	if r != nil {
+		var buf bytes.Buffer
+		if err := EncodeHeaderAndPayload(r, &buf, comp); err != nil {
+			return err
+		}
+		if _, err := stream.Write(buf.Bytes()); err != nil {
+			return err
+		}
 	}
 	return handle(ctx, stream, stream)
 }
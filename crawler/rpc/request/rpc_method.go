@@ -11,9 +11,9 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/libp2p/go-libp2p-core/network"
-	"github.com/libp2p/go-libp2p-core/peer"
-	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/protolambda/ztyp/codec"
 )
 
@@ -94,6 +94,8 @@ type RPCMethod struct {
 	RequestCodec              Codec
 	ResponseChunkCodec        Codec
 	DefaultResponseChunkCount uint64
+	// ResponseContextLen is the number of context bytes preceding successful response chunks
+	ResponseContextLen uint64
 }
 
 type ResponseCode uint8
@@ -138,14 +140,16 @@ type ChunkedResponseHandler interface {
 	ReadRaw() ([]byte, error)
 	ReadErrMsg() (string, error)
 	ReadObj(dest codec.Deserializable) error
+	ContextBytes() []byte
 }
 
 type chRespHandler struct {
-	m          *RPCMethod
-	r          io.Reader
-	result     ResponseCode
-	chunkSize  uint64
-	chunkIndex uint64
+	m            *RPCMethod
+	r            io.Reader
+	result       ResponseCode
+	chunkSize    uint64
+	chunkIndex   uint64
+	contextBytes []byte
 }
 
 func (c *chRespHandler) ChunkSize() uint64 {
@@ -160,6 +164,10 @@ func (c *chRespHandler) ResultCode() ResponseCode {
 	return c.result
 }
 
+func (c *chRespHandler) ContextBytes() []byte {
+	return c.contextBytes
+}
+
 func (c *chRespHandler) ReadRaw() ([]byte, error) {
 	var buf bytes.Buffer
 	_, err := buf.ReadFrom(io.LimitReader(c.r, int64(c.chunkSize)))
@@ -179,19 +187,25 @@ func (c *chRespHandler) ReadObj(dest codec.Deserializable) error {
 func (m *RPCMethod) RunRequest(ctx context.Context, newStreamFn NewStreamFn,
 	peerID peer.ID, comp Compression, req RequestInput, maxRespChunks uint64, madeRequest func() error,
 	onResponse OnResponseListener) error {
-	handleChunks := ResponseChunkHandler(func(ctx context.Context, chunkIndex uint64, chunkSize uint64, result ResponseCode, r io.Reader, w io.Writer) error {
+	handleChunks := ResponseChunkHandler(func(ctx context.Context, chunkIndex uint64, chunkSize uint64, result ResponseCode, contextBytes []byte, r io.Reader, w io.Writer) error {
 		return onResponse(&chRespHandler{
-			m:          m,
-			r:          r,
-			result:     result,
-			chunkSize:  chunkSize,
-			chunkIndex: chunkIndex,
+			m:            m,
+			r:            r,
+			result:       result,
+			chunkSize:    chunkSize,
+			chunkIndex:   chunkIndex,
+			contextBytes: contextBytes,
 		})
 	})
 
-	reqR, err := req.Reader(m.RequestCodec)
-	if err != nil {
-		return err
+	// methods without request data must not write anything to the stream
+	var reqR io.Reader
+	if m.RequestCodec.MaxByteLen() > 0 {
+		var err error
+		reqR, err = req.Reader(m.RequestCodec)
+		if err != nil {
+			return err
+		}
 	}
 
 	protocolID := m.Protocol
@@ -205,7 +219,7 @@ func (m *RPCMethod) RunRequest(ctx context.Context, newStreamFn NewStreamFn,
 		}
 	}
 
-	respHandler := handleChunks.MakeResponseHandler(maxRespChunks, maxChunkContentSize, comp)
+	respHandler := handleChunks.MakeResponseHandler(maxRespChunks, maxChunkContentSize, m.ResponseContextLen, comp)
 
 	handler := ResponseHandler(func(ctx context.Context, r io.Reader, w io.WriteCloser) error {
 		if err := madeRequest(); err != nil {
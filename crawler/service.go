@@ -6,17 +6,22 @@ package crawler
 
 import (
 	"eth2-crawler/crawler/crawl"
+	"eth2-crawler/crawler/network"
 	ipResolver "eth2-crawler/resolver"
+	"eth2-crawler/store/enrstore"
+	"eth2-crawler/store/nodestore"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
+	"eth2-crawler/store/uptimestore"
+	"eth2-crawler/utils/config"
 
 	"github.com/ethereum/go-ethereum/log"
-
-	"github.com/ethereum/go-ethereum/params"
 )
 
-// Start starts the crawler service
-func Start(peerStore peerstore.Provider, historyStore record.Provider, ipResolver ipResolver.Provider) {
+// Start starts the crawler service for the given network
+func Start(peerStore peerstore.Provider, historyStore record.Provider, enrStore enrstore.Provider,
+	nodeStore nodestore.Provider, uptimeStore uptimestore.Provider, ipResolver ipResolver.Provider, eth2Network *network.Network,
+	cfg *config.Crawler, trigger crawl.SnapshotTrigger) {
 	h := log.CallerFileHandler(log.StdoutHandler)
 	log.Root().SetHandler(h)
 
@@ -25,7 +30,7 @@ func Start(peerStore peerstore.Provider, historyStore record.Provider, ipResolve
 	)
 	log.Root().SetHandler(handler)
 
-	err := crawl.Initialize(peerStore, historyStore, ipResolver, params.V5Bootnodes)
+	err := crawl.Initialize(peerStore, historyStore, enrStore, nodeStore, uptimeStore, ipResolver, eth2Network, cfg, trigger)
 	if err != nil {
 		panic(err)
 	}
@@ -6,17 +6,23 @@ package util
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"net"
 	"time"
 
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
-	"github.com/libp2p/go-libp2p-core/crypto"
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	beacon "github.com/protolambda/zrnt/eth2/beacon/common"
 	"github.com/protolambda/ztyp/codec"
+	"github.com/robfig/cron/v3"
 )
 
 func AddrsFromEnode(node *enode.Node) (*peer.AddrInfo, error) {
@@ -42,35 +48,108 @@ func AddrsFromEnode(node *enode.Node) (*peer.AddrInfo, error) {
 	return peerInfo, nil
 }
 
+// EnodeToMultiAddr returns the tcp then quic addresses of the node, for the IPv4 and IPv6 endpoints of its record
 func EnodeToMultiAddr(node *enode.Node) ([]multiaddr.Multiaddr, error) {
 	multiAddrs := []multiaddr.Multiaddr{}
 
-	ipScheme := "ip4"
-	if len(node.IP()) == net.IPv6len {
-		ipScheme = "ip6"
-	}
-	pubkey := node.Pubkey()
-	peerID, err := peer.IDFromPublicKey(crypto.PubKey((*crypto.Secp256k1PublicKey)(pubkey)))
+	pubkey, err := ConvertToInterfacePubkey(node.Pubkey())
 	if err != nil {
 		return nil, err
 	}
-	tcpMultiAddrStr := fmt.Sprintf("/%s/%s/tcp/%d/p2p/%s", ipScheme, node.IP().String(), node.TCP(), peerID)
-	tcpMultiAddr, err := multiaddr.NewMultiaddr(tcpMultiAddrStr)
+	peerID, err := peer.IDFromPublicKey(pubkey)
 	if err != nil {
 		return nil, err
 	}
-	multiAddrs = append(multiAddrs, tcpMultiAddr)
-
-	udpMultiAddrStr := fmt.Sprintf("/%s/%s/udp/%d/p2p/%s", ipScheme, node.IP().String(), node.UDP(), peerID)
-	udpMultiAddr, err := multiaddr.NewMultiaddr(udpMultiAddrStr)
-	if err != nil {
-		return nil, err
+	endpoints := EnodeEndpoints(node)
+	for _, proto := range []string{"tcp", "quic"} {
+		for _, e := range endpoints {
+			ipScheme := "ip4"
+			if e.IP.To4() == nil {
+				ipScheme = "ip6"
+			}
+			var addrStr string
+			switch {
+			case proto == "tcp" && e.TCP != 0:
+				addrStr = fmt.Sprintf("/%s/%s/tcp/%d/p2p/%s", ipScheme, e.IP.String(), e.TCP, peerID)
+			case proto == "quic" && e.QUIC != 0:
+				addrStr = fmt.Sprintf("/%s/%s/udp/%d/quic-v1/p2p/%s", ipScheme, e.IP.String(), e.QUIC, peerID)
+			default:
+				continue
+			}
+			addr, err := multiaddr.NewMultiaddr(addrStr)
+			if err != nil {
+				return nil, err
+			}
+			multiAddrs = append(multiAddrs, addr)
+		}
 	}
-	multiAddrs = append(multiAddrs, udpMultiAddr)
 
 	return multiAddrs, nil
 }
 
+// ConvertToInterfacePubkey converts the secp256k1 public key of a node to its libp2p form
+func ConvertToInterfacePubkey(pubkey *ecdsa.PublicKey) (crypto.PubKey, error) {
+	return crypto.UnmarshalSecp256k1PublicKey(ethcrypto.CompressPubkey(pubkey))
+}
+
+// Endpoint is an ip address of a node with its ports
+type Endpoint struct {
+	IP   net.IP
+	TCP  int
+	UDP  int
+	QUIC int
+}
+
+// Quic is the "quic" key of the node record, the udp port of the QUIC transport
+type Quic uint16
+
+func (v Quic) ENRKey() string {
+	return "quic"
+}
+
+// Quic6 is the "quic6" key of the node record, the udp port of the QUIC transport on the IPv6 address
+type Quic6 uint16
+
+func (v Quic6) ENRKey() string {
+	return "quic6"
+}
+
+// EnodeEndpoints returns the IPv4 endpoint then the IPv6 endpoint of the node record, when present.
+// The IPv6 ports default to the IPv4 ones as the record allows.
+func EnodeEndpoints(node *enode.Node) []*Endpoint {
+	var endpoints []*Endpoint
+	var (
+		ip4   enr.IPv4
+		ip6   enr.IPv6
+		tcp   enr.TCP
+		udp   enr.UDP
+		tcp6  enr.TCP6
+		udp6  enr.UDP6
+		quic  Quic
+		quic6 Quic6
+	)
+	_ = node.Load(&tcp)
+	_ = node.Load(&udp)
+	_ = node.Load(&quic)
+	if node.Load(&ip4) == nil {
+		endpoints = append(endpoints, &Endpoint{IP: net.IP(ip4), TCP: int(tcp), UDP: int(udp), QUIC: int(quic)})
+	}
+	if node.Load(&ip6) == nil {
+		e := &Endpoint{IP: net.IP(ip6), TCP: int(tcp), UDP: int(udp), QUIC: int(quic)}
+		if node.Load(&tcp6) == nil {
+			e.TCP = int(tcp6)
+		}
+		if node.Load(&udp6) == nil {
+			e.UDP = int(udp6)
+		}
+		if node.Load(&quic6) == nil {
+			e.QUIC = int(quic6)
+		}
+		endpoints = append(endpoints, e)
+	}
+	return endpoints
+}
+
 type Eth2ENREntry []byte
 
 func (eee Eth2ENREntry) ENRKey() string {
@@ -106,6 +185,30 @@ func ParseEnrEth2Data(n *enode.Node) (*beacon.Eth2Data, error) {
 	return dat, nil
 }
 
+// ParseEnrEntries returns every entry of the node record, keyed by name.
+// Byte string values are hex encoded, list values are the hex encoded rlp of the list.
+func ParseEnrEntries(n *enode.Node) map[string]string {
+	entries := make(map[string]string)
+	// the elements are the sequence number followed by the key/value pairs
+	elements := n.Record().AppendElements(nil)
+	for i := 1; i+1 < len(elements); i += 2 {
+		key, ok := elements[i].(string)
+		if !ok {
+			continue
+		}
+		raw, ok := elements[i+1].(rlp.RawValue)
+		if !ok {
+			continue
+		}
+		kind, content, _, err := rlp.Split(raw)
+		if err != nil || kind == rlp.List {
+			content = raw
+		}
+		entries[key] = "0x" + hex.EncodeToString(content)
+	}
+	return entries
+}
+
 func ParseEnrAttnets(n *enode.Node) (*beacon.AttnetBits, error) {
 	var attnets AttnetsENREntry
 	if err := n.Load(&attnets); err != nil {
@@ -136,12 +239,79 @@ func (aee AttnetsENREntry) String() string {
 	return hex.EncodeToString(aee)
 }
 
-func getGenesisTime() time.Time {
-	t, _ := time.Parse(time.RFC822, "01 Dec 20 12:00 GMT")
-	return t
+// SyncnetBits is the bitvector of the sync committee subnets a peer is subscribed to
+type SyncnetBits [1]byte
+
+func (sb *SyncnetBits) Deserialize(dr *codec.DecodingReader) error {
+	b, err := dr.ReadByte()
+	if err != nil {
+		return err
+	}
+	sb[0] = b
+	return nil
+}
+
+func (sb SyncnetBits) Serialize(w *codec.EncodingWriter) error {
+	return w.WriteByte(sb[0])
 }
 
-func CurrentBlock() int64 {
-	duration := time.Since(getGenesisTime())
-	return int64((duration / time.Second) / 12)
+func (sb SyncnetBits) ByteLength() uint64 {
+	return 1
+}
+
+func (sb SyncnetBits) FixedLength() uint64 {
+	return 1
+}
+
+// BitLen returns the number of sync committee subnets
+func (sb SyncnetBits) BitLen() uint64 {
+	return 4
+}
+
+// IsSet checks if the peer is subscribed to the subnet
+func (sb SyncnetBits) IsSet(i uint64) bool {
+	return i < sb.BitLen() && (sb[0]>>i)&1 == 1
+}
+
+// CurrentSlot returns the wall-clock slot of a chain starting at genesis
+func CurrentSlot(genesis time.Time, slotDuration time.Duration) int64 {
+	duration := time.Since(genesis)
+	return int64(duration / slotDuration)
+}
+
+// ChapmanEstimate estimates the size of a population from two samples of n1 and n2 individuals,
+// m of them found in both samples. It returns the Chapman estimate and its 95% confidence interval.
+// The lower bound is never below the number of distinct individuals seen.
+func ChapmanEstimate(n1, n2, m int) (estimate, lower, upper float64) {
+	a, b, c := float64(n1), float64(n2), float64(m)
+	estimate = (a+1)*(b+1)/(c+1) - 1
+	variance := (a + 1) * (b + 1) * (a - c) * (b - c) / ((c + 1) * (c + 1) * (c + 2))
+	margin := 1.96 * math.Sqrt(variance)
+	seen := a + b - c
+	return estimate, math.Max(estimate-margin, seen), estimate + margin
+}
+
+// ScheduleBucket returns the latest activation time of the schedule not after t.
+// Constant delay schedules are aligned on multiples of the delay, so that every process
+// running the same schedule finds the same activation times.
+func ScheduleBucket(schedule cron.Schedule, t time.Time) time.Time {
+	if every, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return t.Truncate(every.Delay)
+	}
+	t = t.Truncate(time.Second)
+	// look back further and further until an activation is found
+	for lookback := time.Minute; lookback <= 366*24*time.Hour; lookback *= 2 {
+		next := schedule.Next(t.Add(-lookback))
+		if next.After(t) {
+			continue
+		}
+		for {
+			after := schedule.Next(next)
+			if after.After(t) {
+				return next
+			}
+			next = after
+		}
+	}
+	return t
 }
@@ -7,13 +7,48 @@ import (
 	"testing"
 	"time"
 
+	"github.com/robfig/cron/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func TestCurrentBlock(t *testing.T) {
-	block1 := CurrentBlock()
-	assert.Greater(t, block1, int64(0))
-	time.Sleep(12 * time.Second)
-	block2 := CurrentBlock()
-	assert.Equal(t, block1, block2-1)
+func TestCurrentSlot(t *testing.T) {
+	genesis := time.Now().Add(-10 * time.Second)
+	slot1 := CurrentSlot(genesis, 12*time.Second)
+	assert.Equal(t, int64(0), slot1)
+	time.Sleep(2 * time.Second)
+	slot2 := CurrentSlot(genesis, 12*time.Second)
+	assert.Equal(t, slot1, slot2-1)
+}
+
+func TestChapmanEstimate(t *testing.T) {
+	estimate, lower, upper := ChapmanEstimate(100, 100, 100)
+	assert.Equal(t, float64(100), estimate)
+	assert.Equal(t, float64(100), lower)
+	assert.Equal(t, float64(100), upper)
+
+	estimate, lower, upper = ChapmanEstimate(500, 400, 100)
+	assert.InDelta(t, 1988.1, estimate, 0.1)
+	assert.Less(t, lower, estimate)
+	assert.Greater(t, upper, estimate)
+	assert.GreaterOrEqual(t, lower, float64(800))
+}
+
+func TestScheduleBucket(t *testing.T) {
+	now := time.Date(2022, 3, 4, 10, 35, 20, 0, time.Local)
+
+	daily, err := cron.ParseStandard("@daily")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 3, 4, 0, 0, 0, 0, time.Local), ScheduleBucket(daily, now))
+
+	quarter, err := cron.ParseStandard("*/15 * * * *")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 3, 4, 10, 30, 0, 0, time.Local), ScheduleBucket(quarter, now))
+	// an activation time is its own bucket
+	assert.Equal(t, time.Date(2022, 3, 4, 10, 30, 0, 0, time.Local),
+		ScheduleBucket(quarter, time.Date(2022, 3, 4, 10, 30, 0, 0, time.Local)))
+
+	every, err := cron.ParseStandard("@every 1h")
+	require.NoError(t, err)
+	assert.Equal(t, now.Truncate(time.Hour), ScheduleBucket(every, now))
 }
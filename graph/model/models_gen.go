@@ -7,12 +7,131 @@ type AggregateData struct {
 	Count int    `json:"count"`
 }
 
+type AggregateDataOverTime struct {
+	Time float64          `json:"time"`
+	Data []*AggregateData `json:"data"`
+}
+
+type Churn struct {
+	Time   float64 `json:"time"`
+	Joins  int     `json:"joins"`
+	Leaves int     `json:"leaves"`
+}
+
+type ClientForkReadiness struct {
+	Client                      string  `json:"client"`
+	Count                       int     `json:"count"`
+	ReadyPercentage             float64 `json:"readyPercentage"`
+	NotReadyPercentage          float64 `json:"notReadyPercentage"`
+	AnnouncedPercentage         float64 `json:"announcedPercentage"`
+	ReadyNotAnnouncedPercentage float64 `json:"readyNotAnnouncedPercentage"`
+}
+
+type ClientPropagation struct {
+	Client               string  `json:"client"`
+	Peers                int     `json:"peers"`
+	Blocks               int     `json:"blocks"`
+	MeanBlockLatency     float64 `json:"meanBlockLatency"`
+	Aggregates           int     `json:"aggregates"`
+	MeanAggregateLatency float64 `json:"meanAggregateLatency"`
+}
+
+type ClientPropagationOverTime struct {
+	Time float64              `json:"time"`
+	Data []*ClientPropagation `json:"data"`
+}
+
 type ClientVersionAggregation struct {
 	Client   string           `json:"client"`
 	Count    int              `json:"count"`
 	Versions []*AggregateData `json:"versions"`
 }
 
+type ClientVersionAggregationOverTime struct {
+	Time float64                     `json:"time"`
+	Data []*ClientVersionAggregation `json:"data"`
+}
+
+type CrawlRound struct {
+	Round        int     `json:"round"`
+	Start        float64 `json:"start"`
+	End          float64 `json:"end"`
+	Discovered   int     `json:"discovered"`
+	Queried      int     `json:"queried"`
+	Responsive   int     `json:"responsive"`
+	Eth2Nodes    int     `json:"eth2Nodes"`
+	MinDistance  int     `json:"minDistance"`
+	EmptyBuckets int     `json:"emptyBuckets"`
+}
+
+type DiscoveryCounts struct {
+	Discovered  int `json:"discovered"`
+	Eth2        int `json:"eth2"`
+	Dialable    int `json:"dialable"`
+	Connectable int `json:"connectable"`
+}
+
+type ENREntry struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type ENRRecord struct {
+	Seq          int      `json:"seq"`
+	Enr          string   `json:"enr"`
+	IP           string   `json:"ip"`
+	TCPPort      int      `json:"tcpPort"`
+	UDPPort      int      `json:"udpPort"`
+	IP6          string   `json:"ip6"`
+	TCP6Port     int      `json:"tcp6Port"`
+	UDP6Port     int      `json:"udp6Port"`
+	Attnets      string   `json:"attnets"`
+	ForkDigest   string   `json:"forkDigest"`
+	ForkName     string   `json:"forkName"`
+	NextForkName string   `json:"nextForkName"`
+	ReplacedAt   *float64 `json:"replacedAt"`
+}
+
+type FinalityConsensus struct {
+	TotalNodes    int                    `json:"totalNodes"`
+	MinorityNodes int                    `json:"minorityNodes"`
+	Checkpoints   []*FinalizedCheckpoint `json:"checkpoints"`
+}
+
+type FinalityConsensusOverTime struct {
+	Time float64            `json:"time"`
+	Data *FinalityConsensus `json:"data"`
+}
+
+type FinalizedCheckpoint struct {
+	Epoch    int              `json:"epoch"`
+	Root     string           `json:"root"`
+	Count    int              `json:"count"`
+	Minority bool             `json:"minority"`
+	Clients  []*AggregateData `json:"clients"`
+}
+
+type ForkAggregation struct {
+	Fork     string `json:"fork"`
+	NextFork string `json:"nextFork"`
+	Count    int    `json:"count"`
+}
+
+type ForkAggregationOverTime struct {
+	Time float64            `json:"time"`
+	Data []*ForkAggregation `json:"data"`
+}
+
+type ForkReadiness struct {
+	Fork                        string                 `json:"fork"`
+	TotalNodes                  int                    `json:"totalNodes"`
+	ReadyPercentage             float64                `json:"readyPercentage"`
+	NotReadyPercentage          float64                `json:"notReadyPercentage"`
+	AnnouncedPercentage         float64                `json:"announcedPercentage"`
+	ReadyNotAnnouncedPercentage float64                `json:"readyNotAnnouncedPercentage"`
+	Clients                     []*ClientForkReadiness `json:"clients"`
+}
+
 type HeatmapData struct {
 	NetworkType string  `json:"networkType"`
 	ClientType  string  `json:"clientType"`
@@ -23,6 +142,31 @@ type HeatmapData struct {
 	Country     string  `json:"country"`
 }
 
+type NetworkEstimate struct {
+	Time         float64 `json:"time"`
+	Method       string  `json:"method"`
+	FirstSample  int     `json:"firstSample"`
+	SecondSample int     `json:"secondSample"`
+	Recaptured   int     `json:"recaptured"`
+	Estimate     float64 `json:"estimate"`
+	Lower        float64 `json:"lower"`
+	Upper        float64 `json:"upper"`
+}
+
+type NetworkSize struct {
+	ConnectableNodes int              `json:"connectableNodes"`
+	Estimate         *NetworkEstimate `json:"estimate"`
+}
+
+type NodeRecord struct {
+	PeerID        string      `json:"peerId"`
+	Seq           int         `json:"seq"`
+	Enr           string      `json:"enr"`
+	Entries       []*ENREntry `json:"entries"`
+	ForkName      string      `json:"forkName"`
+	UnknownDigest bool        `json:"unknownDigest"`
+}
+
 type NodeStats struct {
 	TotalNodes             int     `json:"totalNodes"`
 	NodeSyncedPercentage   float64 `json:"nodeSyncedPercentage"`
@@ -30,10 +174,51 @@ type NodeStats struct {
 }
 
 type NodeStatsOverTime struct {
-	Time          float64 `json:"time"`
-	TotalNodes    int     `json:"totalNodes"`
-	SyncedNodes   int     `json:"syncedNodes"`
-	UnsyncedNodes int     `json:"unsyncedNodes"`
+	Time          float64          `json:"time"`
+	TotalNodes    int              `json:"totalNodes"`
+	SyncedNodes   int              `json:"syncedNodes"`
+	UnsyncedNodes int              `json:"unsyncedNodes"`
+	Estimate      *NetworkEstimate `json:"estimate"`
+}
+
+type PeerPropagation struct {
+	PeerID               string  `json:"peerId"`
+	ClientType           string  `json:"clientType"`
+	Blocks               int     `json:"blocks"`
+	MeanBlockLatency     float64 `json:"meanBlockLatency"`
+	Aggregates           int     `json:"aggregates"`
+	MeanAggregateLatency float64 `json:"meanAggregateLatency"`
+}
+
+type PeerSyncStatus struct {
+	PeerID           string `json:"peerId"`
+	ClientType       string `json:"clientType"`
+	State            string `json:"state"`
+	HeadSlot         int    `json:"headSlot"`
+	FinalizedEpoch   int    `json:"finalizedEpoch"`
+	Distance         int    `json:"distance"`
+	ObservedDistance int    `json:"observedDistance"`
+	FinalizedLag     int    `json:"finalizedLag"`
+}
+
+type PeerUptime struct {
+	PeerID string         `json:"peerId"`
+	Day    *float64       `json:"day"`
+	Week   *float64       `json:"week"`
+	Month  *float64       `json:"month"`
+	Checks []*UptimeCheck `json:"checks"`
+}
+
+type QuicAdoption struct {
+	Client    string `json:"client"`
+	Count     int    `json:"count"`
+	Announced int    `json:"announced"`
+	Connected int    `json:"connected"`
+}
+
+type QuicAdoptionOverTime struct {
+	Time float64         `json:"time"`
+	Data []*QuicAdoption `json:"data"`
 }
 
 type RegionalStats struct {
@@ -41,3 +226,45 @@ type RegionalStats struct {
 	HostedNodePercentage        float64 `json:"hostedNodePercentage"`
 	NonhostedNodePercentage     float64 `json:"nonhostedNodePercentage"`
 }
+
+type RegionalStatsOverTime struct {
+	Time float64        `json:"time"`
+	Data *RegionalStats `json:"data"`
+}
+
+type SubnetCoverage struct {
+	Subnet int `json:"subnet"`
+	Count  int `json:"count"`
+}
+
+type SubnetStats struct {
+	TotalNodes int               `json:"totalNodes"`
+	Attnets    []*SubnetCoverage `json:"attnets"`
+	Syncnets   []*SubnetCoverage `json:"syncnets"`
+}
+
+type SubnetStatsOverTime struct {
+	Time float64      `json:"time"`
+	Data *SubnetStats `json:"data"`
+}
+
+type UptimeCheck struct {
+	Time    float64 `json:"time"`
+	Success bool    `json:"success"`
+	Failure *string `json:"failure"`
+}
+
+type UptimeDistribution struct {
+	Name   string  `json:"name"`
+	Peers  int     `json:"peers"`
+	Mean   float64 `json:"mean"`
+	Low    int     `json:"low"`
+	Medium int     `json:"medium"`
+	High   int     `json:"high"`
+	Full   int     `json:"full"`
+}
+
+type UptimeDistributionOverTime struct {
+	Time float64               `json:"time"`
+	Data []*UptimeDistribution `json:"data"`
+}
@@ -5,8 +5,16 @@
 package graph
 
 import (
+	"context"
+	"eth2-crawler/crawler/network"
+	"eth2-crawler/graph/model"
+	"eth2-crawler/models"
+	"eth2-crawler/store/enrstore"
+	"eth2-crawler/store/nodestore"
 	"eth2-crawler/store/peerstore"
 	"eth2-crawler/store/record"
+	"eth2-crawler/store/uptimestore"
+	"eth2-crawler/utils/config"
 )
 
 // This file will not be regenerated automatically.
@@ -16,8 +24,84 @@ import (
 type Resolver struct {
 	peerStore    peerstore.Provider
 	historyStore record.Provider
+	enrStore     enrstore.Provider
+	nodeStore    nodestore.Provider
+	uptimeStore  uptimestore.Provider
+	// network is queried when no network argument is provided
+	network       *network.Network
+	forkReadiness config.ForkReadiness
 }
 
-func NewResolver(peerStore peerstore.Provider, historyStore record.Provider) *Resolver {
-	return &Resolver{peerStore: peerStore, historyStore: historyStore}
+func NewResolver(peerStore peerstore.Provider, historyStore record.Provider, enrStore enrstore.Provider,
+	nodeStore nodestore.Provider, uptimeStore uptimestore.Provider, eth2Network *network.Network, forkReadiness config.ForkReadiness) *Resolver {
+	return &Resolver{
+		peerStore:     peerStore,
+		historyStore:  historyStore,
+		enrStore:      enrStore,
+		nodeStore:     nodeStore,
+		uptimeStore:   uptimeStore,
+		network:       eth2Network,
+		forkReadiness: forkReadiness,
+	}
+}
+
+// networkOrDefault returns the requested network or the crawled one if not provided
+func (r *Resolver) networkOrDefault(name *string) string {
+	if name == nil || *name == "" {
+		return r.network.Name
+	}
+	return *name
+}
+
+// snapshots returns the aggregation snapshots of the network between start and end,
+// the first one of every interval in seconds when given. Only the aggregations stored as fields are loaded.
+func (r *Resolver) snapshots(ctx context.Context, start, end float64, interval *float64, network *string, fields ...string) ([]*models.Snapshot, error) {
+	var step int64
+	if interval != nil {
+		step = int64(*interval)
+	}
+	return r.historyStore.GetSnapshots(ctx, r.networkOrDefault(network), int64(start), int64(end), step, fields...)
+}
+
+// aggregateDataOverTime returns the aggregation selected by data from the snapshots, field is its stored name
+func (r *Resolver) aggregateDataOverTime(ctx context.Context, start, end float64, interval *float64, network *string,
+	field string, data func(s *models.Snapshot) []*models.AggregateData) ([]*model.AggregateDataOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, field)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.AggregateDataOverTime, 0)
+	for _, s := range snapshots {
+		result = append(result, &model.AggregateDataOverTime{
+			Time: float64(s.Time),
+			Data: aggregateDataList(data(s)),
+		})
+	}
+	return result, nil
+}
+
+// uptimeDistributionOverTime returns the uptime distribution selected by data from the snapshots, field is its stored name
+func (r *Resolver) uptimeDistributionOverTime(ctx context.Context, start, end float64, interval *float64, network *string,
+	field string, data func(s *models.Snapshot) []*models.UptimeDistribution) ([]*model.UptimeDistributionOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, field)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.UptimeDistributionOverTime, 0)
+	for _, s := range snapshots {
+		result = append(result, &model.UptimeDistributionOverTime{
+			Time: float64(s.Time),
+			Data: uptimeDistributions(data(s)),
+		})
+	}
+	return result, nil
+}
+
+// networkProfile returns the profile of the named network.
+// Only the crawled network and the built-in profiles are known.
+func (r *Resolver) networkProfile(name string) (*network.Network, error) {
+	if name == r.network.Name {
+		return r.network, nil
+	}
+	return network.Profile(name)
 }
@@ -8,15 +8,23 @@ package graph
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
+	eth2Network "eth2-crawler/crawler/network"
 	"eth2-crawler/graph/generated"
 	"eth2-crawler/graph/model"
 	svcModels "eth2-crawler/models"
+	"eth2-crawler/store/peerstore"
+	"eth2-crawler/utils/config"
+	"fmt"
+	"sort"
+	"time"
 
-	"github.com/hashicorp/go-version"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
-func (r *queryResolver) AggregateByAgentName(ctx context.Context) ([]*model.AggregateData, error) {
-	aggregateData, err := r.peerStore.AggregateByAgentName(ctx)
+func (r *queryResolver) AggregateByAgentName(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByAgentName(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
@@ -31,8 +39,8 @@ func (r *queryResolver) AggregateByAgentName(ctx context.Context) ([]*model.Aggr
 	return result, nil
 }
 
-func (r *queryResolver) AggregateByCountry(ctx context.Context) ([]*model.AggregateData, error) {
-	aggregateData, err := r.peerStore.AggregateByCountry(ctx)
+func (r *queryResolver) AggregateByCountry(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByCountry(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
@@ -47,8 +55,8 @@ func (r *queryResolver) AggregateByCountry(ctx context.Context) ([]*model.Aggreg
 	return result, nil
 }
 
-func (r *queryResolver) AggregateByOperatingSystem(ctx context.Context) ([]*model.AggregateData, error) {
-	aggregateData, err := r.peerStore.AggregateByOperatingSystem(ctx)
+func (r *queryResolver) AggregateByOperatingSystem(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByOperatingSystem(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +71,8 @@ func (r *queryResolver) AggregateByOperatingSystem(ctx context.Context) ([]*mode
 	return result, nil
 }
 
-func (r *queryResolver) AggregateByNetwork(ctx context.Context) ([]*model.AggregateData, error) {
-	aggregateData, err := r.peerStore.AggregateByNetworkType(ctx)
+func (r *queryResolver) AggregateByNetwork(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByNetworkType(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
@@ -78,8 +86,8 @@ func (r *queryResolver) AggregateByNetwork(ctx context.Context) ([]*model.Aggreg
 	return result, nil
 }
 
-func (r *queryResolver) AggregateByClientVersion(ctx context.Context) ([]*model.ClientVersionAggregation, error) {
-	aggregateData, err := r.peerStore.AggregateByClientVersion(ctx)
+func (r *queryResolver) AggregateByClientVersion(ctx context.Context, network *string) ([]*model.ClientVersionAggregation, error) {
+	aggregateData, err := r.peerStore.AggregateByClientVersion(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
@@ -102,8 +110,72 @@ func (r *queryResolver) AggregateByClientVersion(ctx context.Context) ([]*model.
 	return result, nil
 }
 
-func (r *queryResolver) GetHeatmapData(ctx context.Context) ([]*model.HeatmapData, error) {
-	peers, err := r.peerStore.ViewAll(ctx)
+func (r *queryResolver) AggregateByGoodbyeReason(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByGoodbyeReason(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateByFailure(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByFailure(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateByPeerState(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByState(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetChurn(ctx context.Context, start float64, end float64, network *string) ([]*model.Churn, error) {
+	data, err := r.peerStore.AggregateChurn(ctx, r.networkOrDefault(network), int64(start), int64(end))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.Churn, 0)
+	for _, v := range data {
+		result = append(result, &model.Churn{
+			Time:   float64(v.Time),
+			Joins:  v.Joins,
+			Leaves: v.Leaves,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetHeatmapData(ctx context.Context, network *string) ([]*model.HeatmapData, error) {
+	peers, err := r.peerStore.ViewAll(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
@@ -131,20 +203,65 @@ func (r *queryResolver) GetHeatmapData(ctx context.Context) ([]*model.HeatmapDat
 	return result, nil
 }
 
-func (r *queryResolver) GetNodeStats(ctx context.Context) (*model.NodeStats, error) {
-	aggregateData, err := r.peerStore.AggregateBySyncStatus(ctx)
+func (r *queryResolver) GetNodeStats(ctx context.Context, network *string) (*model.NodeStats, error) {
+	aggregateData, err := r.peerStore.AggregateBySyncStatus(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
 	return &model.NodeStats{
 		TotalNodes:             aggregateData.Total,
-		NodeSyncedPercentage:   (float64(aggregateData.Synced) / float64(aggregateData.Total)) * 100,
-		NodeUnsyncedPercentage: (float64(aggregateData.Unsynced) / float64(aggregateData.Total)) * 100,
+		NodeSyncedPercentage:   percentage(aggregateData.Synced, aggregateData.Total),
+		NodeUnsyncedPercentage: percentage(aggregateData.Unsynced, aggregateData.Total),
 	}, nil
 }
 
-func (r *queryResolver) GetNodeStatsOverTime(ctx context.Context, start float64, end float64) ([]*model.NodeStatsOverTime, error) {
-	data, err := r.historyStore.GetHistory(ctx, int64(start), int64(end))
+func (r *queryResolver) AggregateBySyncState(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateBySyncState(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetPeerSyncStatuses(ctx context.Context, network *string) ([]*model.PeerSyncStatus, error) {
+	peers, err := r.peerStore.ViewAll(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.PeerSyncStatus{}
+	for i := range peers {
+		if peers[i].Sync == nil {
+			continue
+		}
+		var clientType string
+		if peers[i].UserAgent != nil {
+			clientType = string(peers[i].UserAgent.Name)
+		}
+		result = append(result, &model.PeerSyncStatus{
+			PeerID:           peers[i].ID.String(),
+			ClientType:       clientType,
+			State:            peers[i].Sync.String(),
+			HeadSlot:         int(peers[i].Sync.HeadSlot),
+			FinalizedEpoch:   int(peers[i].Sync.FinalizedEpoch),
+			Distance:         int(peers[i].Sync.Distance),
+			ObservedDistance: int(peers[i].Sync.ObservedDistance),
+			FinalizedLag:     int(peers[i].Sync.FinalizedLag),
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetNodeStatsOverTime(ctx context.Context, start float64, end float64, network *string) ([]*model.NodeStatsOverTime, error) {
+	data, err := r.historyStore.GetHistory(ctx, r.networkOrDefault(network), int64(start), int64(end))
 	if err != nil {
 		return nil, err
 	}
@@ -155,58 +272,622 @@ func (r *queryResolver) GetNodeStatsOverTime(ctx context.Context, start float64,
 			TotalNodes:    v.TotalNodes,
 			SyncedNodes:   v.SyncedNodes,
 			UnsyncedNodes: v.TotalNodes - v.SyncedNodes,
+			Estimate:      networkEstimate(v.Estimate),
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetCrawlRounds(ctx context.Context, start float64, end float64, network *string) ([]*model.CrawlRound, error) {
+	rounds, err := r.historyStore.GetCrawlRounds(ctx, r.networkOrDefault(network), int64(start), int64(end))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.CrawlRound, 0, len(rounds))
+	for _, v := range rounds {
+		result = append(result, &model.CrawlRound{
+			Round:        v.Round,
+			Start:        float64(v.Start),
+			End:          float64(v.End),
+			Discovered:   v.Discovered,
+			Queried:      v.Queried,
+			Responsive:   v.Responsive,
+			Eth2Nodes:    v.Eth2Nodes,
+			MinDistance:  v.MinDistance,
+			EmptyBuckets: v.EmptyBuckets,
 		})
 	}
 	return result, nil
 }
 
-func (r *queryResolver) GetRegionalStats(ctx context.Context) (*model.RegionalStats, error) {
-	countryAggrData, err := r.peerStore.AggregateByCountry(ctx)
+func (r *queryResolver) GetNetworkSize(ctx context.Context, network *string) (*model.NetworkSize, error) {
+	aggregateData, err := r.peerStore.AggregateBySyncStatus(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
+	estimate, err := r.historyStore.LatestEstimate(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+	return &model.NetworkSize{
+		ConnectableNodes: aggregateData.Total,
+		Estimate:         networkEstimate(estimate),
+	}, nil
+}
 
-	networkAggrData, err := r.peerStore.AggregateByNetworkType(ctx)
+func (r *queryResolver) GetDiscoveryCounts(ctx context.Context, network *string) (*model.DiscoveryCounts, error) {
+	since := time.Now().Add(-svcModels.DiscoveryWindow).Unix()
+	kinds, err := r.nodeStore.AggregateByKind(ctx, r.networkOrDefault(network), since)
+	if err != nil {
+		return nil, err
+	}
+	aggregateData, err := r.peerStore.AggregateBySyncStatus(ctx, r.networkOrDefault(network))
 	if err != nil {
 		return nil, err
 	}
 
-	var hostedCount, nonhostedCount, total int
-	for i := range networkAggrData {
-		total += networkAggrData[i].Count
-		if networkAggrData[i].Name == string(svcModels.UsageTypeHosting) {
-			hostedCount += networkAggrData[i].Count
-		} else {
-			nonhostedCount += networkAggrData[i].Count
+	result := &model.DiscoveryCounts{Connectable: aggregateData.Total}
+	for i := range kinds {
+		result.Discovered += kinds[i].Count
+		// the eth2 nodes of other networks are only discovered
+		switch kinds[i].Name {
+		case svcModels.NodeKindEth2:
+			result.Eth2 += kinds[i].Count
+			result.Dialable += kinds[i].Count
+		case svcModels.NodeKindEth2NoTCP:
+			result.Eth2 += kinds[i].Count
 		}
 	}
+	return result, nil
+}
 
-	result := &model.RegionalStats{
-		TotalParticipatingCountries: len(countryAggrData),
-		HostedNodePercentage:        (float64(hostedCount) / float64(total)) * 100,
-		NonhostedNodePercentage:     (float64(nonhostedCount) / float64(total)) * 100,
+func (r *queryResolver) AggregateDiscoveredByENRKeys(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	since := time.Now().Add(-svcModels.DiscoveryWindow).Unix()
+	aggregateData, err := r.nodeStore.AggregateByENRKeys(ctx, r.networkOrDefault(network), since)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
 	}
 	return result, nil
 }
 
-func (r *queryResolver) GetAltairUpgradePercentage(ctx context.Context) (float64, error) {
-	aggregateData, err := r.peerStore.AggregateByClientVersion(ctx)
+func (r *queryResolver) GetRegionalStats(ctx context.Context, network *string) (*model.RegionalStats, error) {
+	countryAggrData, err := r.peerStore.AggregateByCountry(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	networkAggrData, err := r.peerStore.AggregateByNetworkType(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+	return regionalStats(countryAggrData, networkAggrData), nil
+}
+
+func (r *queryResolver) GetAltairUpgradePercentage(ctx context.Context, network *string) (float64, error) {
+	name := r.networkOrDefault(network)
+	profile, err := r.networkProfile(name)
 	if err != nil {
 		return 0, err
 	}
-	// check altair upgrade
-	count := 0
-	total := 0
-	for _, client := range aggregateData {
-		for _, v := range client.Versions {
-			total += v.Count
-			if supportAltairUpgrade(client.Client, v.Name) {
-				count += v.Count
-			}
+	aggregateData, err := r.peerStore.AggregateByFork(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	count, total := countForkUpgrade(profile, aggregateData, eth2Network.Altair)
+	return percentage(count, total), nil
+}
+
+func (r *queryResolver) AggregateByFork(ctx context.Context, network *string) ([]*model.ForkAggregation, error) {
+	aggregateData, err := r.peerStore.AggregateByFork(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.ForkAggregation{}
+	for i := range aggregateData {
+		result = append(result, &model.ForkAggregation{
+			Fork:     aggregateData[i].Fork,
+			NextFork: aggregateData[i].NextFork,
+			Count:    aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetForkReadiness(ctx context.Context, fork string, network *string) (*model.ForkReadiness, error) {
+	minVersions, ok := r.forkReadiness[fork]
+	if !ok {
+		return nil, fmt.Errorf("no client versions configured for fork %s", fork)
+	}
+	name := r.networkOrDefault(network)
+	profile, err := r.networkProfile(name)
+	if err != nil {
+		return nil, err
+	}
+	aggregateData, err := r.peerStore.AggregateByClientFork(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	forkIndex := profile.ForkIndex(fork)
+	var total, ready, announced, readyNotAnnounced int
+	clients := make(map[string]*clientReadinessCount)
+	clientNames := []string{}
+	for _, v := range aggregateData {
+		c, ok := clients[v.Client]
+		if !ok {
+			c = new(clientReadinessCount)
+			clients[v.Client] = c
+			clientNames = append(clientNames, v.Client)
+		}
+		isReady := supportForkUpgrade(minVersions, v.Client, v.Version)
+		isAnnounced := forkIndex >= 0 && (v.NextFork == fork || profile.ForkIndex(v.Fork) >= forkIndex)
+
+		total += v.Count
+		c.total += v.Count
+		if isReady {
+			ready += v.Count
+			c.ready += v.Count
+		}
+		if isAnnounced {
+			announced += v.Count
+			c.announced += v.Count
+		}
+		if isReady && !isAnnounced {
+			readyNotAnnounced += v.Count
+			c.readyNotAnnounced += v.Count
 		}
 	}
-	percentage := float64(count) / float64(total) * 100
-	return percentage, nil
+
+	result := &model.ForkReadiness{
+		Fork:                        fork,
+		TotalNodes:                  total,
+		ReadyPercentage:             percentage(ready, total),
+		NotReadyPercentage:          percentage(total-ready, total),
+		AnnouncedPercentage:         percentage(announced, total),
+		ReadyNotAnnouncedPercentage: percentage(readyNotAnnounced, total),
+		Clients:                     []*model.ClientForkReadiness{},
+	}
+	sort.Strings(clientNames)
+	for _, client := range clientNames {
+		c := clients[client]
+		result.Clients = append(result.Clients, &model.ClientForkReadiness{
+			Client:                      client,
+			Count:                       c.total,
+			ReadyPercentage:             percentage(c.ready, c.total),
+			NotReadyPercentage:          percentage(c.total-c.ready, c.total),
+			AnnouncedPercentage:         percentage(c.announced, c.total),
+			ReadyNotAnnouncedPercentage: percentage(c.readyNotAnnounced, c.total),
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetSubnetCoverage(ctx context.Context, network *string) (*model.SubnetStats, error) {
+	peers, err := r.peerStore.ViewAll(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+	return subnetStats(svcModels.CountSubnets(peers)), nil
+}
+
+func (r *queryResolver) GetFinalityConsensus(ctx context.Context, network *string) (*model.FinalityConsensus, error) {
+	aggregateData, err := r.peerStore.AggregateByFinalizedCheckpoint(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+	return finalityConsensus(aggregateData), nil
+}
+
+func (r *queryResolver) GetENRHistory(ctx context.Context, peerID string) ([]*model.ENRRecord, error) {
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.ENRRecord{}
+	current, err := r.peerStore.View(ctx, id)
+	if err != nil && !errors.Is(err, peerstore.ErrPeerNotFound) {
+		return nil, err
+	}
+	if current != nil {
+		result = append(result, enrRecord(svcModels.NewENRRecord(current), false))
+	}
+	records, err := r.enrStore.List(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range records {
+		result = append(result, enrRecord(v, true))
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetNodeRecord(ctx context.Context, peerID string) (*model.NodeRecord, error) {
+	id, err := peer.Decode(peerID)
+	if err != nil {
+		return nil, err
+	}
+	p, err := r.peerStore.View(ctx, id)
+	if err != nil {
+		if errors.Is(err, peerstore.ErrPeerNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result := &model.NodeRecord{
+		PeerID:        p.ID.String(),
+		Seq:           int(p.ENRSeq),
+		Enr:           p.ENR,
+		Entries:       []*model.ENREntry{},
+		ForkName:      p.ForkName,
+		UnknownDigest: p.UnknownDigest,
+	}
+	for _, entry := range p.ENREntries {
+		result.Entries = append(result.Entries, &model.ENREntry{
+			Key:   entry.Key,
+			Value: entry.Value,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) PeerUptime(ctx context.Context, id string) (*model.PeerUptime, error) {
+	peerID, err := peer.Decode(id)
+	if err != nil {
+		return nil, err
+	}
+	p, err := r.peerStore.View(ctx, peerID)
+	if err != nil {
+		if errors.Is(err, peerstore.ErrPeerNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	checks, err := r.uptimeStore.List(ctx, peerID, time.Now().Add(-svcModels.UptimeMonth).Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.PeerUptime{
+		PeerID: p.ID.String(),
+		Checks: []*model.UptimeCheck{},
+	}
+	if p.Uptime != nil {
+		result.Day = p.Uptime.Day
+		result.Week = p.Uptime.Week
+		result.Month = p.Uptime.Month
+	}
+	for _, c := range checks {
+		check := &model.UptimeCheck{
+			Time:    float64(c.Time.Unix()),
+			Success: c.Success,
+		}
+		if c.Failure != "" {
+			failure := c.Failure
+			check.Failure = &failure
+		}
+		result.Checks = append(result.Checks, check)
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateUptimeByClient(ctx context.Context, network *string) ([]*model.UptimeDistribution, error) {
+	data, err := r.peerStore.AggregateUptimeByClient(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+	return uptimeDistributions(data), nil
+}
+
+func (r *queryResolver) AggregateUptimeByProvider(ctx context.Context, network *string) ([]*model.UptimeDistribution, error) {
+	data, err := r.peerStore.AggregateUptimeByProvider(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+	return uptimeDistributions(data), nil
+}
+
+func (r *queryResolver) AggregateByENRKey(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByENRKey(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateByIPVersion(ctx context.Context, network *string) ([]*model.AggregateData, error) {
+	aggregateData, err := r.peerStore.AggregateByIPVersion(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.AggregateData{}
+	for i := range aggregateData {
+		result = append(result, &model.AggregateData{
+			Name:  aggregateData[i].Name,
+			Count: aggregateData[i].Count,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateQuicAdoption(ctx context.Context, network *string) ([]*model.QuicAdoption, error) {
+	aggregateData, err := r.peerStore.AggregateQUICAdoption(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.QuicAdoption{}
+	for _, v := range aggregateData {
+		result = append(result, &model.QuicAdoption{
+			Client:    v.Client,
+			Count:     v.Count,
+			Announced: v.Announced,
+			Connected: v.Connected,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateByAgentNameOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "agent_name", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.AgentName
+	})
+}
+
+func (r *queryResolver) AggregateByCountryOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "country", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.Country
+	})
+}
+
+func (r *queryResolver) AggregateByOperatingSystemOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "operating_system", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.OperatingSystem
+	})
+}
+
+func (r *queryResolver) AggregateByNetworkOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "network_type", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.NetworkType
+	})
+}
+
+func (r *queryResolver) AggregateByClientVersionOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.ClientVersionAggregationOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, "client_version")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.ClientVersionAggregationOverTime, 0)
+	for _, s := range snapshots {
+		data := []*model.ClientVersionAggregation{}
+		for _, v := range s.ClientVersion {
+			data = append(data, &model.ClientVersionAggregation{
+				Client:   v.Client,
+				Count:    v.Count,
+				Versions: aggregateDataList(v.Versions),
+			})
+		}
+		result = append(result, &model.ClientVersionAggregationOverTime{
+			Time: float64(s.Time),
+			Data: data,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateByForkOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.ForkAggregationOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, "fork")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.ForkAggregationOverTime, 0)
+	for _, s := range snapshots {
+		data := []*model.ForkAggregation{}
+		for _, v := range s.Fork {
+			data = append(data, &model.ForkAggregation{
+				Fork:     v.Fork,
+				NextFork: v.NextFork,
+				Count:    v.Count,
+			})
+		}
+		result = append(result, &model.ForkAggregationOverTime{
+			Time: float64(s.Time),
+			Data: data,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateByGoodbyeReasonOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "goodbye_reason", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.GoodbyeReason
+	})
+}
+
+func (r *queryResolver) AggregateByFailureOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "failure", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.Failure
+	})
+}
+
+func (r *queryResolver) AggregateByPeerStateOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "peer_state", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.PeerState
+	})
+}
+
+func (r *queryResolver) AggregateBySyncStateOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "sync_state", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.SyncState
+	})
+}
+
+func (r *queryResolver) AggregateByENRKeyOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "enr_key", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.ENRKey
+	})
+}
+
+func (r *queryResolver) AggregateByIPVersionOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "ip_version", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.IPVersion
+	})
+}
+
+func (r *queryResolver) AggregateQuicAdoptionOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.QuicAdoptionOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, "quic_adoption")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.QuicAdoptionOverTime, 0)
+	for _, s := range snapshots {
+		data := []*model.QuicAdoption{}
+		for _, v := range s.QUICAdoption {
+			data = append(data, &model.QuicAdoption{
+				Client:    v.Client,
+				Count:     v.Count,
+				Announced: v.Announced,
+				Connected: v.Connected,
+			})
+		}
+		result = append(result, &model.QuicAdoptionOverTime{
+			Time: float64(s.Time),
+			Data: data,
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) AggregateDiscoveredByENRKeysOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.AggregateDataOverTime, error) {
+	return r.aggregateDataOverTime(ctx, start, end, interval, network, "discovered_enr_keys", func(s *svcModels.Snapshot) []*svcModels.AggregateData {
+		return s.DiscoveredENRKeys
+	})
+}
+
+func (r *queryResolver) AggregateUptimeByClientOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.UptimeDistributionOverTime, error) {
+	return r.uptimeDistributionOverTime(ctx, start, end, interval, network, "uptime_by_client", func(s *svcModels.Snapshot) []*svcModels.UptimeDistribution {
+		return s.UptimeByClient
+	})
+}
+
+func (r *queryResolver) AggregateUptimeByProviderOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.UptimeDistributionOverTime, error) {
+	return r.uptimeDistributionOverTime(ctx, start, end, interval, network, "uptime_by_provider", func(s *svcModels.Snapshot) []*svcModels.UptimeDistribution {
+		return s.UptimeByProvider
+	})
+}
+
+func (r *queryResolver) GetRegionalStatsOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.RegionalStatsOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, "country", "network_type")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.RegionalStatsOverTime, 0)
+	for _, s := range snapshots {
+		result = append(result, &model.RegionalStatsOverTime{
+			Time: float64(s.Time),
+			Data: regionalStats(s.Country, s.NetworkType),
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetSubnetCoverageOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.SubnetStatsOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, "subnets")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.SubnetStatsOverTime, 0)
+	for _, s := range snapshots {
+		result = append(result, &model.SubnetStatsOverTime{
+			Time: float64(s.Time),
+			Data: subnetStats(s.Subnets),
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetFinalityConsensusOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.FinalityConsensusOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, "finalized_checkpoint")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.FinalityConsensusOverTime, 0)
+	for _, s := range snapshots {
+		result = append(result, &model.FinalityConsensusOverTime{
+			Time: float64(s.Time),
+			Data: finalityConsensus(s.FinalizedCheckpoint),
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetPeerPropagation(ctx context.Context, network *string) ([]*model.PeerPropagation, error) {
+	peers, err := r.peerStore.ViewAll(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.PeerPropagation{}
+	for i := range peers {
+		if peers[i].Propagation == nil {
+			continue
+		}
+		var clientType string
+		if peers[i].UserAgent != nil {
+			clientType = string(peers[i].UserAgent.Name)
+		}
+		result = append(result, &model.PeerPropagation{
+			PeerID:               peers[i].ID.String(),
+			ClientType:           clientType,
+			Blocks:               int(peers[i].Propagation.Blocks),
+			MeanBlockLatency:     peers[i].Propagation.MeanBlockLatency(),
+			Aggregates:           int(peers[i].Propagation.Aggregates),
+			MeanAggregateLatency: peers[i].Propagation.MeanAggregateLatency(),
+		})
+	}
+	return result, nil
+}
+
+func (r *queryResolver) GetClientPropagation(ctx context.Context, network *string) ([]*model.ClientPropagation, error) {
+	aggregateData, err := r.peerStore.AggregatePropagationByClient(ctx, r.networkOrDefault(network))
+	if err != nil {
+		return nil, err
+	}
+	return clientPropagation(aggregateData), nil
+}
+
+func (r *queryResolver) GetClientPropagationOverTime(ctx context.Context, start float64, end float64, interval *float64, network *string) ([]*model.ClientPropagationOverTime, error) {
+	snapshots, err := r.snapshots(ctx, start, end, interval, network, "client_propagation")
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*model.ClientPropagationOverTime, 0)
+	for _, s := range snapshots {
+		result = append(result, &model.ClientPropagationOverTime{
+			Time: float64(s.Time),
+			Data: clientPropagation(s.ClientPropagation),
+		})
+	}
+	return result, nil
 }
 
 // Query returns generated.QueryResolver implementation.
@@ -214,43 +895,196 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
 type queryResolver struct{ *Resolver }
 
-func supportAltairUpgrade(clientName, ver string) bool {
-	if len(ver) != 0 && ver[0:1] != "v" {
-		ver = "v" + ver
+// countForkUpgrade returns the number of peers that are on the fork, on a later one or announce it as next fork
+func countForkUpgrade(profile *eth2Network.Network, aggregateData []*svcModels.ForkAggregation, fork string) (count int, total int) {
+	forkIndex := profile.ForkIndex(fork)
+	for _, v := range aggregateData {
+		total += v.Count
+		if forkIndex < 0 {
+			continue
+		}
+		if v.NextFork == fork || profile.ForkIndex(v.Fork) >= forkIndex {
+			count += v.Count
+		}
+	}
+	return count, total
+}
+
+type clientReadinessCount struct {
+	total             int
+	ready             int
+	announced         int
+	readyNotAnnounced int
+}
+
+// supportForkUpgrade checks the client version against the minimum version supporting the fork
+func supportForkUpgrade(minVersions map[string]string, clientName, ver string) bool {
+	minVer, ok := minVersions[clientName]
+	if !ok {
+		return false
 	}
-	clientVersion, err := version.NewVersion(ver)
+	clientVersion, err := config.ParseVersion(ver)
 	if err != nil {
 		return false
 	}
+	v, err := config.ParseVersion(minVer)
+	if err != nil {
+		return false
+	}
+	return clientVersion.GreaterThanOrEqual(v)
+}
 
-	switch svcModels.ClientName(clientName) {
-	case svcModels.PrysmClient:
-		v, _ := version.NewVersion("v2.0.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
-		}
-	case svcModels.TekuClient:
-		v, _ := version.NewVersion("v21.9.2")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
-		}
-	case svcModels.LighthouseClient:
-		v, _ := version.NewVersion("v2.0.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
+func percentage(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(count) / float64(total) * 100
+}
+
+// regionalStats returns the number of countries and the share of hosted peers from the aggregations
+// of the peers by country and by network type
+func regionalStats(country, networkType []*svcModels.AggregateData) *model.RegionalStats {
+	var hostedCount, nonhostedCount, total int
+	for i := range networkType {
+		total += networkType[i].Count
+		if networkType[i].Name == string(svcModels.UsageTypeHosting) {
+			hostedCount += networkType[i].Count
+		} else {
+			nonhostedCount += networkType[i].Count
 		}
-	case svcModels.NimbusClient:
-		v, _ := version.NewVersion("v1.5.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
+	}
+
+	return &model.RegionalStats{
+		TotalParticipatingCountries: len(country),
+		HostedNodePercentage:        percentage(hostedCount, total),
+		NonhostedNodePercentage:     percentage(nonhostedCount, total),
+	}
+}
+
+// subnetStats returns the subnet coverage of the counts, snapshots taken before the subnets were stored have none
+func subnetStats(counts *svcModels.SubnetCounts) *model.SubnetStats {
+	if counts == nil {
+		return &model.SubnetStats{
+			Attnets:  []*model.SubnetCoverage{},
+			Syncnets: []*model.SubnetCoverage{},
 		}
-	case svcModels.LodestarClient:
-		v, _ := version.NewVersion("v0.31.0")
-		if clientVersion.GreaterThanOrEqual(v) {
-			return true
+	}
+	return &model.SubnetStats{
+		TotalNodes: counts.Total,
+		Attnets:    subnetCoverage(counts.Attnets),
+		Syncnets:   subnetCoverage(counts.Syncnets),
+	}
+}
+
+func subnetCoverage(counts []int) []*model.SubnetCoverage {
+	result := make([]*model.SubnetCoverage, 0, len(counts))
+	for i, count := range counts {
+		result = append(result, &model.SubnetCoverage{
+			Subnet: i,
+			Count:  count,
+		})
+	}
+	return result
+}
+
+func networkEstimate(estimate *svcModels.NetworkEstimate) *model.NetworkEstimate {
+	if estimate == nil {
+		return nil
+	}
+	return &model.NetworkEstimate{
+		Time:         float64(estimate.Time),
+		Method:       estimate.Method,
+		FirstSample:  estimate.FirstSample,
+		SecondSample: estimate.SecondSample,
+		Recaptured:   estimate.Recaptured,
+		Estimate:     estimate.Estimate,
+		Lower:        estimate.Lower,
+		Upper:        estimate.Upper,
+	}
+}
+
+func enrRecord(record *svcModels.ENRRecord, replaced bool) *model.ENRRecord {
+	result := &model.ENRRecord{
+		Seq:          int(record.Seq),
+		Enr:          record.ENR,
+		IP:           record.IP,
+		TCPPort:      record.TCPPort,
+		UDPPort:      record.UDPPort,
+		IP6:          record.IP6,
+		TCP6Port:     record.TCP6Port,
+		UDP6Port:     record.UDP6Port,
+		Attnets:      hex.EncodeToString(record.Attnets[:]),
+		ForkDigest:   hex.EncodeToString(record.ForkDigest[:]),
+		ForkName:     record.ForkName,
+		NextForkName: record.NextForkName,
+	}
+	if replaced {
+		replacedAt := float64(record.ReplacedAt)
+		result.ReplacedAt = &replacedAt
+	}
+	return result
+}
+
+func uptimeDistributions(data []*svcModels.UptimeDistribution) []*model.UptimeDistribution {
+	result := []*model.UptimeDistribution{}
+	for _, v := range data {
+		result = append(result, &model.UptimeDistribution{
+			Name:   v.Name,
+			Peers:  v.Peers,
+			Mean:   v.Mean,
+			Low:    v.Low,
+			Medium: v.Medium,
+			High:   v.High,
+			Full:   v.Full,
+		})
+	}
+	return result
+}
+
+func aggregateDataList(data []*svcModels.AggregateData) []*model.AggregateData {
+	result := []*model.AggregateData{}
+	for _, v := range data {
+		result = append(result, &model.AggregateData{
+			Name:  v.Name,
+			Count: v.Count,
+		})
+	}
+	return result
+}
+
+// finalityConsensus groups the aggregation of the peers by finalized checkpoint and flags the minority roots
+func finalityConsensus(data []*svcModels.CheckpointAggregation) *model.FinalityConsensus {
+	result := &model.FinalityConsensus{
+		Checkpoints: []*model.FinalizedCheckpoint{},
+	}
+	for _, cp := range svcModels.GroupByCheckpoint(data) {
+		result.TotalNodes += cp.Count
+		if cp.Minority {
+			result.MinorityNodes += cp.Count
 		}
-	default:
-		return false
+		result.Checkpoints = append(result.Checkpoints, &model.FinalizedCheckpoint{
+			Epoch:    int(cp.Epoch),
+			Root:     cp.Root,
+			Count:    cp.Count,
+			Minority: cp.Minority,
+			Clients:  aggregateDataList(cp.Clients),
+		})
+	}
+	return result
+}
+
+// clientPropagation returns the mean propagation latencies of the aggregation of the peers by client
+func clientPropagation(data []*svcModels.ClientPropagation) []*model.ClientPropagation {
+	result := []*model.ClientPropagation{}
+	for _, v := range data {
+		result = append(result, &model.ClientPropagation{
+			Client:               v.Client,
+			Peers:                v.Peers,
+			Blocks:               int(v.Blocks),
+			MeanBlockLatency:     v.MeanBlockLatency(),
+			Aggregates:           int(v.Aggregates),
+			MeanAggregateLatency: v.MeanAggregateLatency(),
+		})
 	}
-	return false
+	return result
 }
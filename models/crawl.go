@@ -0,0 +1,40 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import "github.com/ethereum/go-ethereum/log"
+
+// CrawlRound is the snapshot of a complete sweep of the discovery keyspace
+type CrawlRound struct {
+	Round   int    `json:"round" bson:"round"`
+	Network string `json:"network" bson:"network"`
+	Start   int64  `json:"start" bson:"start"`
+	End     int64  `json:"end" bson:"end"`
+	// Discovered is the number of distinct nodes found during the round
+	Discovered int `json:"discovered" bson:"discovered"`
+	// Queried is the number of nodes asked for their buckets, Responsive the ones that answered
+	Queried    int `json:"queried" bson:"queried"`
+	Responsive int `json:"responsive" bson:"responsive"`
+	// Eth2Nodes is the number of nodes found announcing a fork of the crawled network
+	Eth2Nodes int `json:"eth2_nodes" bson:"eth2_nodes"`
+	// MinDistance is the log distance of the closest bucket asked for, EmptyBuckets the number of
+	// empty buckets in a row ending the walk of a node, zero when every bucket was asked for
+	MinDistance  int `json:"min_distance" bson:"min_distance"`
+	EmptyBuckets int `json:"empty_buckets" bson:"empty_buckets"`
+}
+
+// Log returns the context logger of the round
+func (r *CrawlRound) Log() log.Ctx {
+	return log.Ctx{
+		"round":         r.Round,
+		"network":       r.Network,
+		"duration":      r.End - r.Start,
+		"discovered":    r.Discovered,
+		"queried":       r.Queried,
+		"responsive":    r.Responsive,
+		"eth2_nodes":    r.Eth2Nodes,
+		"min_distance":  r.MinDistance,
+		"empty_buckets": r.EmptyBuckets,
+	}
+}
@@ -8,6 +8,28 @@ const (
 	SyncTypeUnsynced = "unsynced"
 )
 
+// Transports peers are connected with
+const (
+	TransportTCP  = "tcp"
+	TransportQUIC = "quic"
+)
+
+// QUICAdoption represents the QUIC support of the peers of a client
+type QUICAdoption struct {
+	Client string `json:"client"`
+	Count  int    `json:"count"`
+	// Announced is the number of peers announcing a QUIC port, Connected the ones last connected over QUIC
+	Announced int `json:"announced"`
+	Connected int `json:"connected"`
+}
+
+// IP versions of peers
+const (
+	IPVersion4         = "ipv4"
+	IPVersion6         = "ipv6"
+	IPVersionDualStack = "dual-stack"
+)
+
 // AggregateData represents data of group by queries
 type AggregateData struct {
 	Name  string `json:"name"`
@@ -21,10 +43,27 @@ type ClientVersionAggregation struct {
 	Versions []*AggregateData `json:"versions"`
 }
 
+// ForkAggregation represents peer count by current fork and announced next fork
+type ForkAggregation struct {
+	Fork     string `json:"fork"`
+	NextFork string `json:"next_fork"`
+	Count    int    `json:"count"`
+}
+
+// ClientForkAggregation represents peer count by client version and fork
+type ClientForkAggregation struct {
+	Client   string `json:"client"`
+	Version  string `json:"version"`
+	Fork     string `json:"fork"`
+	NextFork string `json:"next_fork"`
+	Count    int    `json:"count"`
+}
+
 type HistoryCount struct {
-	Time        int64 `json:"time"`
-	TotalNodes  int   `json:"total_nodes"`
-	SyncedNodes int   `json:"synced_nodes"`
+	Time        int64            `json:"time"`
+	TotalNodes  int              `json:"total_nodes"`
+	SyncedNodes int              `json:"synced_nodes"`
+	Estimate    *NetworkEstimate `json:"estimate,omitempty"`
 }
 
 type SyncAggregateData struct {
@@ -0,0 +1,72 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// kinds of the nodes found in discovery
+const (
+	// NodeKindEth2 nodes announce an eth2 entry of the network and a tcp port, they are stored as peers too
+	NodeKindEth2 = "eth2"
+	// NodeKindEth2NoTCP nodes announce an eth2 entry of the network without a tcp port to dial
+	NodeKindEth2NoTCP = "eth2_no_tcp"
+	// NodeKindEth2Foreign nodes announce the eth2 entry of a fork digest unknown to the network,
+	// they belong to another network sharing the discovery DHT
+	NodeKindEth2Foreign = "eth2_foreign"
+	// NodeKindOther nodes don't announce an eth2 entry
+	NodeKindOther = "other"
+)
+
+// DiscoveryWindow is the time the nodes seen in discovery are counted for
+const DiscoveryWindow = 24 * time.Hour
+
+// DiscoveredNode is a node seen in discovery, whether it can be dialed or not
+type DiscoveredNode struct {
+	// ID is the discovery node id
+	ID      string   `json:"id" bson:"_id"`
+	Network string   `json:"network" bson:"network"`
+	Kind    string   `json:"kind" bson:"kind"`
+	Seq     uint64   `json:"seq" bson:"seq"`
+	IP      string   `json:"ip" bson:"ip"`
+	ENRKeys []string `json:"enr_keys" bson:"enr_keys"`
+	// ForkDigest is set for the eth2 nodes
+	ForkDigest string `json:"fork_digest,omitempty" bson:"fork_digest,omitempty"`
+
+	FirstSeen int64 `json:"first_seen" bson:"first_seen"`
+	LastSeen  int64 `json:"last_seen" bson:"last_seen"`
+}
+
+// NewDiscoveredNode initializes the discovery record of a node found on the given network.
+// The eth2 data is nil for nodes not announcing it.
+func NewDiscoveredNode(node *enode.Node, eth2Data *common.Eth2Data, kind, network string, entries map[string]string) *DiscoveredNode {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	now := time.Now().Unix()
+	n := &DiscoveredNode{
+		ID:        node.ID().String(),
+		Network:   network,
+		Kind:      kind,
+		Seq:       node.Seq(),
+		ENRKeys:   keys,
+		FirstSeen: now,
+		LastSeen:  now,
+	}
+	if ip := node.IP(); ip != nil {
+		n.IP = ip.String()
+	}
+	if eth2Data != nil {
+		n.ForkDigest = hex.EncodeToString(eth2Data.ForkDigest[:])
+	}
+	return n
+}
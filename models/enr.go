@@ -0,0 +1,68 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// ENRRecord is a version of the node record of a peer that was replaced by a higher sequence number
+type ENRRecord struct {
+	ID      uuid.UUID `json:"id" bson:"_id"`
+	PeerID  peer.ID   `json:"peer_id" bson:"peer_id"`
+	Network string    `json:"network" bson:"network"`
+	Seq     uint64    `json:"seq" bson:"seq"`
+	ENR     string    `json:"enr" bson:"enr"`
+
+	IP        string   `json:"ip" bson:"ip"`
+	TCPPort   int      `json:"tcp_port" bson:"tcp_port"`
+	UDPPort   int      `json:"udp_port" bson:"udp_port"`
+	IP6       string   `json:"ip6,omitempty" bson:"ip6"`
+	TCP6Port  int      `json:"tcp6_port,omitempty" bson:"tcp6_port"`
+	UDP6Port  int      `json:"udp6_port,omitempty" bson:"udp6_port"`
+	QUICPort  int      `json:"quic_port,omitempty" bson:"quic_port"`
+	QUIC6Port int      `json:"quic6_port,omitempty" bson:"quic6_port"`
+	Addrs     []string `json:"addrs,omitempty" bson:"addrs"`
+
+	Attnets         common.AttnetBits `json:"attnets" bson:"attnets"`
+	ForkDigest      common.ForkDigest `json:"fork_digest" bson:"fork_digest"`
+	NextForkVersion common.Version    `json:"next_fork_version" bson:"next_fork_version"`
+	NextForkEpoch   common.Epoch      `json:"next_fork_epoch" bson:"next_fork_epoch"`
+	ForkName        string            `json:"fork_name" bson:"fork_name"`
+	NextForkName    string            `json:"next_fork_name,omitempty" bson:"next_fork_name"`
+
+	// ReplacedAt is the time the next version of the record was found
+	ReplacedAt int64 `json:"replaced_at" bson:"replaced_at"`
+}
+
+// NewENRRecord keeps the node record fields of the peer being replaced
+func NewENRRecord(p *Peer) *ENRRecord {
+	return &ENRRecord{
+		ID:              uuid.New(),
+		PeerID:          p.ID,
+		Network:         p.Network,
+		Seq:             p.ENRSeq,
+		ENR:             p.ENR,
+		IP:              p.IP,
+		TCPPort:         p.TCPPort,
+		UDPPort:         p.UDPPort,
+		IP6:             p.IP6,
+		TCP6Port:        p.TCP6Port,
+		UDP6Port:        p.UDP6Port,
+		QUICPort:        p.QUICPort,
+		QUIC6Port:       p.QUIC6Port,
+		Addrs:           p.Addrs,
+		Attnets:         p.Attnets,
+		ForkDigest:      p.ForkDigest,
+		NextForkVersion: p.NextForkVersion,
+		NextForkEpoch:   p.NextForkEpoch,
+		ForkName:        p.ForkName,
+		NextForkName:    p.NextForkName,
+		ReplacedAt:      time.Now().Unix(),
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+// EstimateCaptureRecapture is the method of estimates comparing two consecutive samples of discovered nodes
+const EstimateCaptureRecapture = "capture-recapture"
+
+// NetworkEstimate is a statistical estimate of the number of nodes of the network,
+// including the ones that can't be connected to
+type NetworkEstimate struct {
+	Time   int64  `json:"time" bson:"time"`
+	Method string `json:"method" bson:"method"`
+	// FirstSample and SecondSample are the number of nodes of both samples, Recaptured the ones found in both
+	FirstSample  int `json:"first_sample" bson:"first_sample"`
+	SecondSample int `json:"second_sample" bson:"second_sample"`
+	Recaptured   int `json:"recaptured" bson:"recaptured"`
+	// Estimate is the estimated number of nodes, Lower and Upper the bounds of its 95% confidence interval
+	Estimate float64 `json:"estimate" bson:"estimate"`
+	Lower    float64 `json:"lower" bson:"lower"`
+	Upper    float64 `json:"upper" bson:"upper"`
+}
@@ -0,0 +1,68 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import "sort"
+
+// CheckpointAggregation represents peer count by finalized checkpoint and client
+type CheckpointAggregation struct {
+	Epoch  uint64 `json:"epoch"`
+	Root   string `json:"root"`
+	Client string `json:"client"`
+	Count  int    `json:"count"`
+}
+
+// FinalizedCheckpoint holds the peers on a finalized checkpoint
+type FinalizedCheckpoint struct {
+	Epoch uint64 `json:"epoch"`
+	Root  string `json:"root"`
+	Count int    `json:"count"`
+	// Minority is set when another root of the same epoch has more peers
+	Minority bool             `json:"minority"`
+	Clients  []*AggregateData `json:"clients"`
+}
+
+// GroupByCheckpoint groups the aggregation by checkpoint and flags the minority roots of every epoch.
+// Peers crawled at different times are on different epochs, only roots of the same epoch can conflict.
+func GroupByCheckpoint(data []*CheckpointAggregation) []*FinalizedCheckpoint {
+	type key struct {
+		epoch uint64
+		root  string
+	}
+	checkpoints := make(map[key]*FinalizedCheckpoint)
+	result := make([]*FinalizedCheckpoint, 0)
+	for _, v := range data {
+		k := key{epoch: v.Epoch, root: v.Root}
+		cp, ok := checkpoints[k]
+		if !ok {
+			cp = &FinalizedCheckpoint{Epoch: v.Epoch, Root: v.Root, Clients: []*AggregateData{}}
+			checkpoints[k] = cp
+			result = append(result, cp)
+		}
+		cp.Count += v.Count
+		cp.Clients = append(cp.Clients, &AggregateData{Name: v.Client, Count: v.Count})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Epoch != result[j].Epoch {
+			return result[i].Epoch > result[j].Epoch
+		}
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].Root < result[j].Root
+	})
+	// the first checkpoint of every epoch has the most peers
+	for i := 1; i < len(result); i++ {
+		if result[i].Epoch == result[i-1].Epoch {
+			result[i].Minority = true
+		}
+	}
+	for _, cp := range result {
+		sort.Slice(cp.Clients, func(i, j int) bool {
+			return cp.Clients[i].Count > cp.Clients[j].Count
+		})
+	}
+	return result
+}
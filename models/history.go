@@ -12,15 +12,23 @@ import (
 type History struct {
 	ID        uuid.UUID `bson:"_id" json:"id"`
 	Time      int64     `json:"time" bson:"time"`
+	Network   string    `json:"network" bson:"network"`
 	SyncNodes int       `bson:"sync_nodes" json:"sync_nodes"`
 	Eth2Nodes int       `bson:"eth_2_nodes" json:"eth_2_nodes"`
+	// Bucket is the scheduled time the record was taken for, there is a single record per bucket
+	Bucket int64 `bson:"bucket,omitempty" json:"bucket,omitempty"`
+	// Estimate is the latest network size estimate, including the nodes that are not connectable
+	Estimate *NetworkEstimate `bson:"estimate,omitempty" json:"estimate,omitempty"`
 }
 
-func NewHistory(syncNodes int, eth2Nodes int) *History {
+// NewHistory initializes the record of the bucket
+func NewHistory(network string, bucket int64, syncNodes int, eth2Nodes int) *History {
 	t := time.Now()
 	return &History{
 		ID:        uuid.New(),
 		Time:      t.Unix(),
+		Bucket:    bucket,
+		Network:   network,
 		SyncNodes: syncNodes,
 		Eth2Nodes: eth2Nodes,
 	}
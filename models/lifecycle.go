@@ -0,0 +1,73 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import "time"
+
+// lifecycle states of a peer
+const (
+	// PeerStateNew peers were never connected to
+	PeerStateNew = "new"
+	// PeerStateActive peers answered their last update
+	PeerStateActive = "active"
+	// PeerStateFlaky peers were active and failed their last update
+	PeerStateFlaky = "flaky"
+	// PeerStateUnreachable peers reached the bad score, they are still updated with backoff
+	PeerStateUnreachable = "unreachable"
+	// PeerStateRetired peers stayed unreachable longer than the retention, they are archived
+	// and only updated again when discovered again. They become active if that update succeeds
+	PeerStateRetired = "retired"
+)
+
+// MaxTransitions is the number of state transitions kept on a peer, the peer store keeps all of them
+const MaxTransitions = 64
+
+// StateTransition is a change of the lifecycle state of a peer
+type StateTransition struct {
+	From string `json:"from" bson:"from"`
+	To   string `json:"to" bson:"to"`
+	Time int64  `json:"time" bson:"time"`
+}
+
+// Churn holds the peers joining and leaving the network on a day
+type Churn struct {
+	// Time is the start of the day
+	Time   int64 `json:"time"`
+	Joins  int   `json:"joins"`
+	Leaves int   `json:"leaves"`
+}
+
+// SetState moves the peer to the given lifecycle state and records the transition
+func (p *Peer) SetState(state string) {
+	if p.State == state {
+		return
+	}
+	now := time.Now().Unix()
+	p.Transitions = append(p.Transitions, &StateTransition{From: p.State, To: state, Time: now})
+	if len(p.Transitions) > MaxTransitions {
+		p.Transitions = p.Transitions[len(p.Transitions)-MaxTransitions:]
+	}
+	p.State = state
+	p.StateSince = now
+}
+
+// UpdateState moves the peer along its lifecycle after an update.
+// Unreachable peers are not connectable anymore, they retire after the retention.
+// A retired peer is only brought back by a successful update.
+func (p *Peer) UpdateState(connected bool, retention time.Duration) {
+	p.Recheck = false
+	switch {
+	case connected:
+		p.SetState(PeerStateActive)
+	case p.Score <= ScoreBad:
+		p.IsConnectable = false
+		if p.State == PeerStateUnreachable && time.Since(time.Unix(p.StateSince, 0)) > retention {
+			p.SetState(PeerStateRetired)
+		} else if p.State != PeerStateRetired {
+			p.SetState(PeerStateUnreachable)
+		}
+	case p.State == PeerStateActive:
+		p.SetState(PeerStateFlaky)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateState(t *testing.T) {
+	retention := 24 * time.Hour
+	longAgo := time.Now().Add(-2 * retention).Unix()
+	recently := time.Now().Add(-time.Hour).Unix()
+	tests := []struct {
+		name        string
+		state       string
+		since       int64
+		score       Score
+		recheck     bool
+		connected   bool
+		after       string
+		transitions int
+	}{
+		{name: "new connected", state: PeerStateNew, score: ScoreGood, connected: true, after: PeerStateActive, transitions: 1},
+		{name: "new failed", state: PeerStateNew, score: ScoreGood - 1, after: PeerStateNew},
+		{name: "active connected", state: PeerStateActive, score: ScoreGood, connected: true, after: PeerStateActive},
+		{name: "active failed", state: PeerStateActive, score: ScoreGood - 1, after: PeerStateFlaky, transitions: 1},
+		{name: "flaky connected", state: PeerStateFlaky, score: ScoreGood, connected: true, after: PeerStateActive, transitions: 1},
+		{name: "flaky bad score", state: PeerStateFlaky, score: ScoreBad, after: PeerStateUnreachable, transitions: 1},
+		{name: "new bad score", state: PeerStateNew, score: ScoreBad, after: PeerStateUnreachable, transitions: 1},
+		{name: "unreachable within retention", state: PeerStateUnreachable, since: recently, score: ScoreBad, after: PeerStateUnreachable},
+		{name: "unreachable past retention", state: PeerStateUnreachable, since: longAgo, score: ScoreBad, after: PeerStateRetired, transitions: 1},
+		{name: "unreachable connected", state: PeerStateUnreachable, since: longAgo, score: ScoreGood, connected: true, after: PeerStateActive, transitions: 1},
+		{name: "retired failed recheck", state: PeerStateRetired, since: longAgo, score: ScoreBad, recheck: true, after: PeerStateRetired},
+		{name: "retired connected", state: PeerStateRetired, since: longAgo, score: ScoreGood, recheck: true, connected: true, after: PeerStateActive, transitions: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Peer{State: tt.state, StateSince: tt.since, Score: tt.score, IsConnectable: true, Recheck: tt.recheck}
+			p.UpdateState(tt.connected, retention)
+			assert.Equal(t, tt.after, p.State)
+			assert.Len(t, p.Transitions, tt.transitions)
+			assert.False(t, p.Recheck)
+			if tt.transitions > 0 {
+				assert.Equal(t, &StateTransition{From: tt.state, To: tt.after, Time: p.StateSince}, p.Transitions[0])
+			} else {
+				assert.Equal(t, tt.since, p.StateSince)
+			}
+			if tt.score <= ScoreBad {
+				assert.False(t, p.IsConnectable)
+			}
+		})
+	}
+}
+
+func TestSetStateKeepsLastTransitions(t *testing.T) {
+	p := &Peer{State: PeerStateNew}
+	for i := 0; i < MaxTransitions+10; i++ {
+		if i%2 == 0 {
+			p.SetState(PeerStateActive)
+		} else {
+			p.SetState(PeerStateFlaky)
+		}
+	}
+	assert.Len(t, p.Transitions, MaxTransitions)
+	last := p.Transitions[len(p.Transitions)-1]
+	assert.Equal(t, p.State, last.To)
+
+	// setting the current state records nothing
+	p.SetState(p.State)
+	assert.Len(t, p.Transitions, MaxTransitions)
+	assert.Equal(t, last, p.Transitions[len(p.Transitions)-1])
+}
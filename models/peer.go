@@ -7,22 +7,24 @@ package models
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"eth2-crawler/crawler/util"
 
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
-	ic "github.com/libp2p/go-libp2p-core/crypto"
-	"github.com/libp2p/go-libp2p-core/peer"
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	"github.com/protolambda/zrnt/eth2/beacon/common"
 )
 
-// 256 epochs
-const blockIgnoreThreshold = 8192
-
 // ClientName defines the type for eth2 client name
 type ClientName string
 
@@ -65,6 +67,8 @@ const (
 	VersionUnknown = "unknown"
 	StatusSynced   = "synced"
 	StatusUnsynced = "unsynced"
+	StatusBehind   = "behind"
+	StatusStalled  = "stalled"
 )
 
 // UserAgent holds peer's client related info
@@ -116,50 +120,203 @@ type GeoLocation struct {
 
 // Sync holds peer sync related info
 type Sync struct {
-	Status   bool `json:"status" bson:"status"`
-	Distance int  `json:"distance" bson:"distance"` // sync distance in percentage
+	Status bool `json:"status" bson:"status"` // true when synced
+	// State is either synced, behind or stalled
+	State          string `json:"state" bson:"state"`
+	HeadSlot       int64  `json:"head_slot" bson:"head_slot"`
+	FinalizedEpoch int64  `json:"finalized_epoch" bson:"finalized_epoch"`
+	// Distance is the number of slots the head is behind the wall-clock slot
+	Distance int64 `json:"distance" bson:"distance"`
+	// ObservedDistance is the number of slots the head is behind the head of the majority of peers
+	ObservedDistance int64 `json:"observed_distance" bson:"observed_distance"`
+	// FinalizedLag is the number of epochs the finalized checkpoint is behind the one of the majority of peers
+	FinalizedLag int64 `json:"finalized_lag" bson:"finalized_lag"`
 }
 
 // String returns the sync status
 func (s *Sync) String() string {
+	if s.State != "" {
+		return s.State
+	}
 	if s.Status {
 		return StatusSynced
 	}
 	return StatusUnsynced
 }
 
+// ChainStatus holds the chain status announced by the peer
+type ChainStatus struct {
+	FinalizedRoot  string `json:"finalized_root" bson:"finalized_root"`
+	FinalizedEpoch uint64 `json:"finalized_epoch" bson:"finalized_epoch"`
+	HeadRoot       string `json:"head_root" bson:"head_root"`
+	HeadSlot       uint64 `json:"head_slot" bson:"head_slot"`
+}
+
+// SyncThresholds holds the limits between the sync states
+type SyncThresholds struct {
+	// SyncedSlots is the maximum distance of synced peers
+	SyncedSlots int64
+	// BehindSlots is the maximum distance of peers catching up, farther ones are stalled
+	BehindSlots int64
+	// FinalizedLagEpochs is the maximum finalized checkpoint lag of synced peers
+	FinalizedLagEpochs int64
+}
+
+// ENREntry is an entry of the node record. Entries are stored as a list rather than a document,
+// record keys are free-form and may hold the '.' and '$' characters field names can't.
+type ENREntry struct {
+	Key   string `json:"key" bson:"key"`
+	Value string `json:"value" bson:"value"`
+}
+
+// NewENREntries returns the entries of the node record sorted by key
+func NewENREntries(entries map[string]string) []*ENREntry {
+	result := make([]*ENREntry, 0, len(entries))
+	for key, value := range entries {
+		result = append(result, &ENREntry{Key: key, Value: value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+// Metadata holds the peer metadata fetched over the metadata rpc
+type Metadata struct {
+	SeqNumber uint64            `json:"seq_number" bson:"seq_number"`
+	Attnets   common.AttnetBits `json:"attnets" bson:"attnets"`
+	Syncnets  util.SyncnetBits  `json:"syncnets" bson:"syncnets"`
+}
+
+// sources a peer can be found from
+const (
+	SourceDiscv5  = "discv5"
+	SourceInbound = "inbound"
+)
+
+// Goodbye holds the last goodbye reason received from the peer
+type Goodbye struct {
+	Code   uint64 `json:"code" bson:"code"`
+	Reason string `json:"reason" bson:"reason"`
+	Time   int64  `json:"time" bson:"time"`
+}
+
+// BlockProbe holds the result of requesting blocks from the peer
+type BlockProbe struct {
+	// ServesBlocks is set when the peer answered with at least one block
+	ServesBlocks bool `json:"serves_blocks" bson:"serves_blocks"`
+	// HeadVerified is set when the peer served the block of the head it claims
+	HeadVerified bool `json:"head_verified" bson:"head_verified"`
+	// EarliestSlot is the oldest block found, the peer may serve older ones
+	EarliestSlot uint64 `json:"earliest_slot" bson:"earliest_slot"`
+	Time         int64  `json:"time" bson:"time"`
+}
+
+// failure categories of a connection attempt
+const (
+	FailureDialTimeout         = "dial_timeout"
+	FailureConnectionRefused   = "connection_refused"
+	FailureSecurityHandshake   = "security_handshake"
+	FailureProtocolNegotiation = "protocol_negotiation"
+	FailureStreamReset         = "stream_reset"
+	FailureStatusDecode        = "status_decode"
+	FailureIdentifyMissing     = "identify_missing"
+	FailureOther               = "other"
+)
+
+// Diagnostics holds the failed connection attempts to the peer
+type Diagnostics struct {
+	LastFailure     string `json:"last_failure" bson:"last_failure"`
+	LastError       string `json:"last_error" bson:"last_error"`
+	LastFailureTime int64  `json:"last_failure_time" bson:"last_failure_time"`
+	// Failures counts the failed attempts by category
+	Failures map[string]int64 `json:"failures" bson:"failures"`
+}
+
 // Peer holds all information of a eth2 peer
 type Peer struct {
 	ID     peer.ID `json:"id" bson:"_id"`
 	NodeID string  `json:"node_id" bson:"node_id"`
 	Pubkey string  `json:"pubkey" bson:"pubkey"`
 
-	IP      string   `json:"ip" bson:"ip"`
-	TCPPort int      `json:"tcp_port" bson:"tcp_port"`
-	UDPPort int      `json:"udp_port" bson:"udp_port"`
-	Addrs   []string `json:"addrs,omitempty" bson:"addrs"`
-
-	Attnets common.AttnetBits `json:"enr_attnets,omitempty" bson:"attnets"`
+	Network string `json:"network" bson:"network"`
+	Source  string `json:"source" bson:"source"`
+
+	IP      string `json:"ip" bson:"ip"`
+	TCPPort int    `json:"tcp_port" bson:"tcp_port"`
+	UDPPort int    `json:"udp_port" bson:"udp_port"`
+	// IP6 is set for dual-stack and IPv6 only peers, IP holds the IPv4 address or the IPv6 one when there is none
+	IP6      string `json:"ip6,omitempty" bson:"ip6"`
+	TCP6Port int    `json:"tcp6_port,omitempty" bson:"tcp6_port"`
+	UDP6Port int    `json:"udp6_port,omitempty" bson:"udp6_port"`
+	// QUICPort and QUIC6Port are the udp ports of the QUIC transport announced in the node record
+	QUICPort  int      `json:"quic_port,omitempty" bson:"quic_port"`
+	QUIC6Port int      `json:"quic6_port,omitempty" bson:"quic6_port"`
+	Addrs     []string `json:"addrs,omitempty" bson:"addrs"`
+	// Transport is the transport of the last successful connection
+	Transport string `json:"transport,omitempty" bson:"transport"`
+	// ENRSeq is the sequence number of the node record the peer was found with
+	ENRSeq uint64 `json:"enr_seq" bson:"enr_seq"`
+	// ENR is the text form of the node record, ENREntries holds all its entries
+	ENR        string      `json:"enr,omitempty" bson:"enr"`
+	ENREntries []*ENREntry `json:"enr_entries,omitempty" bson:"enr_entries"`
+
+	Attnets  common.AttnetBits `json:"enr_attnets,omitempty" bson:"attnets"`
+	Metadata *Metadata         `json:"metadata,omitempty" bson:"metadata"`
 
 	ForkDigest      common.ForkDigest `json:"fork_digest" bson:"fork_digest"`
 	NextForkVersion common.Version    `json:"next_fork_version" bson:"next_fork_version"`
+	NextForkEpoch   common.Epoch      `json:"next_fork_epoch" bson:"next_fork_epoch"`
+	ForkName        string            `json:"fork_name" bson:"fork_name"`
+	NextForkName    string            `json:"next_fork_name,omitempty" bson:"next_fork_name"`
+	// UnknownDigest is set when the fork digest is not on the fork schedule, the peer is never dialed
+	UnknownDigest bool `json:"unknown_digest" bson:"unknown_digest"`
 
 	ProtocolVersion string       `json:"protocol_version,omitempty" bson:"protocol_version"`
 	UserAgent       *UserAgent   `json:"user_agent,omitempty" bson:"user_agent"`
 	UserAgentRaw    string       `json:"user_agent_raw" bson:"user_agent_raw"`
 	GeoLocation     *GeoLocation `json:"geo_location" bson:"geo_location"`
 
-	Sync  *Sync `json:"sync" bson:"sync"`
-	Score Score `json:"score" bson:"score"`
+	Sync    *Sync    `json:"sync" bson:"sync"`
+	Score   Score    `json:"score" bson:"score"`
+	Goodbye *Goodbye `json:"goodbye,omitempty" bson:"goodbye"`
+
+	Diagnostics *Diagnostics `json:"diagnostics,omitempty" bson:"diagnostics"`
+
+	ChainStatus *ChainStatus `json:"chain_status,omitempty" bson:"chain_status"`
+	// MinorityFork is set when another root of the same finalized epoch has more peers
+	MinorityFork bool `json:"minority_fork" bson:"minority_fork"`
+
+	BlockProbe *BlockProbe `json:"block_probe,omitempty" bson:"block_probe"`
+	// Propagation is only written by the gossip observer
+	Propagation *Propagation `json:"propagation,omitempty" bson:"propagation,omitempty"`
+
+	// Uptime is computed from the outcome of the updates of the last month
+	Uptime *Uptime `json:"uptime,omitempty" bson:"uptime"`
 
 	IsConnectable bool  `json:"is_connectable" bson:"is_connectable"`
 	LastConnected int64 `json:"last_connected" bson:"last_connected"`
 	LastUpdated   int64 `json:"last_updated" bson:"last_updated"`
+	// NextCheckAt is the time the peer is due for an update, new peers are due at once
+	NextCheckAt int64 `json:"next_check_at" bson:"next_check_at"`
+	// FailedChecks counts the consecutive failed updates
+	FailedChecks int `json:"failed_checks" bson:"failed_checks"`
+	// Recheck is set when a retired peer is found again, it is updated once more
+	Recheck bool `json:"recheck" bson:"recheck"`
+
+	// FirstSeen is the time the peer was stored, it is kept when the peer retires and comes back
+	FirstSeen   int64              `json:"first_seen" bson:"first_seen"`
+	State       string             `json:"state" bson:"state"`
+	StateSince  int64              `json:"state_since" bson:"state_since"`
+	Transitions []*StateTransition `json:"transitions,omitempty" bson:"transitions"`
 }
 
-// NewPeer initializes new peer
-func NewPeer(node *enode.Node, eth2Data *common.Eth2Data) (*Peer, error) {
-	pk := ic.PubKey((*ic.Secp256k1PublicKey)(node.Pubkey()))
+// NewPeer initializes new peer found on the given network
+func NewPeer(node *enode.Node, eth2Data *common.Eth2Data, network string) (*Peer, error) {
+	pk, err := util.ConvertToInterfacePubkey(node.Pubkey())
+	if err != nil {
+		return nil, err
+	}
 	pkByte, err := pk.Raw()
 	if err != nil {
 		return nil, err
@@ -168,6 +325,9 @@ func NewPeer(node *enode.Node, eth2Data *common.Eth2Data) (*Peer, error) {
 	if err != nil {
 		return nil, err
 	}
+	if addr == nil {
+		return nil, errors.New("no address available")
+	}
 	addrStr := make([]string, 0)
 	for _, madd := range addr.Addrs {
 		addrStr = append(addrStr, madd.String())
@@ -178,19 +338,105 @@ func NewPeer(node *enode.Node, eth2Data *common.Eth2Data) (*Peer, error) {
 	if err == nil {
 		attnetsVal = *attnets
 	}
-	return &Peer{
+	p := &Peer{
 		ID:              addr.ID,
 		NodeID:          node.ID().String(),
 		Pubkey:          hex.EncodeToString(pkByte),
+		Network:         network,
 		IP:              node.IP().String(),
 		TCPPort:         node.TCP(),
 		UDPPort:         node.UDP(),
 		Addrs:           addrStr,
+		ENRSeq:          node.Seq(),
+		ENR:             node.String(),
+		ENREntries:      NewENREntries(util.ParseEnrEntries(node)),
 		ForkDigest:      eth2Data.ForkDigest,
 		NextForkVersion: eth2Data.NextForkVersion,
+		NextForkEpoch:   eth2Data.NextForkEpoch,
 		Attnets:         attnetsVal,
 		Score:           ScoreGood,
-	}, nil
+		Source:          SourceDiscv5,
+		FirstSeen:       time.Now().Unix(),
+		State:           PeerStateNew,
+		StateSince:      time.Now().Unix(),
+	}
+	for _, e := range util.EnodeEndpoints(node) {
+		if e.IP.To4() == nil {
+			p.IP6 = e.IP.String()
+			p.TCP6Port = e.TCP
+			p.UDP6Port = e.UDP
+			p.QUIC6Port = e.QUIC
+		} else {
+			p.QUICPort = e.QUIC
+		}
+	}
+	return p, nil
+}
+
+// NewInboundPeer initializes a peer that connected to the crawler.
+// The addresses must be the listen addresses announced by the peer.
+func NewInboundPeer(id peer.ID, addrs []ma.Multiaddr, eth2Data *common.Eth2Data, network string) (*Peer, error) {
+	pk, err := id.ExtractPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := pk.(*ic.Secp256k1PublicKey); !ok {
+		return nil, errors.New("unsupported public key type")
+	}
+	pkByte, err := pk.Raw()
+	if err != nil {
+		return nil, err
+	}
+	secpKey, err := crypto.DecompressPubkey(pkByte)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Peer{
+		ID:              id,
+		NodeID:          enode.PubkeyToIDV4(secpKey).String(),
+		Pubkey:          hex.EncodeToString(pkByte),
+		Network:         network,
+		Addrs:           make([]string, 0),
+		ForkDigest:      eth2Data.ForkDigest,
+		NextForkVersion: eth2Data.NextForkVersion,
+		NextForkEpoch:   eth2Data.NextForkEpoch,
+		Score:           ScoreGood,
+		Source:          SourceInbound,
+		FirstSeen:       time.Now().Unix(),
+		State:           PeerStateNew,
+		StateSince:      time.Now().Unix(),
+	}
+	for _, addr := range addrs {
+		p.Addrs = append(p.Addrs, addr.String())
+		ip, err := manet.ToIP(addr)
+		if err != nil {
+			continue
+		}
+		port, err := addr.ValueForProtocol(ma.P_TCP)
+		if err != nil {
+			continue
+		}
+		if ip.To4() == nil {
+			if p.IP6 == "" {
+				p.IP6 = ip.String()
+				p.TCP6Port, _ = strconv.Atoi(port)
+			}
+			continue
+		}
+		if p.IP == "" {
+			p.IP = ip.String()
+			p.TCPPort, _ = strconv.Atoi(port)
+		}
+	}
+	// IPv6 only peers
+	if p.IP == "" {
+		p.IP, p.TCPPort = p.IP6, p.TCP6Port
+	}
+	if p.IP == "" {
+		return nil, errors.New("no tcp address available")
+	}
+	return p, nil
 }
 
 // SetProtocolVersion sets peer's protocol version
@@ -262,6 +508,74 @@ nameChecker:
 	p.UserAgentRaw = ag
 }
 
+// SetFork sets the fork the peer is on and the next fork it announces.
+// Unknown tells the fork digest is not on the fork schedule of the network.
+func (p *Peer) SetFork(current, next string, unknown bool) {
+	p.ForkName = current
+	p.NextForkName = next
+	p.UnknownDigest = unknown
+}
+
+// SetMetadata sets the metadata fetched from the peer
+func (p *Peer) SetMetadata(seqNumber uint64, attnets common.AttnetBits, syncnets util.SyncnetBits) {
+	p.Metadata = &Metadata{
+		SeqNumber: seqNumber,
+		Attnets:   attnets,
+		Syncnets:  syncnets,
+	}
+}
+
+// SubscribedAttnets returns the attestation subnets of the peer.
+// Metadata is preferred over the ENR as it is more recent.
+func (p *Peer) SubscribedAttnets() common.AttnetBits {
+	if p.Metadata != nil {
+		return p.Metadata.Attnets
+	}
+	return p.Attnets
+}
+
+// SetGoodbye sets the goodbye reason received from the peer
+func (p *Peer) SetGoodbye(code uint64, reason string) {
+	p.Goodbye = &Goodbye{
+		Code:   code,
+		Reason: reason,
+		Time:   time.Now().Unix(),
+	}
+}
+
+// RecordFailure records a failed connection attempt of the given category
+func (p *Peer) RecordFailure(category string, err error) {
+	if p.Diagnostics == nil {
+		p.Diagnostics = &Diagnostics{}
+	}
+	if p.Diagnostics.Failures == nil {
+		p.Diagnostics.Failures = make(map[string]int64)
+	}
+	p.Diagnostics.LastFailure = category
+	// the error of an earlier failure doesn't belong to this one
+	p.Diagnostics.LastError = ""
+	if err != nil {
+		p.Diagnostics.LastError = err.Error()
+	}
+	p.Diagnostics.LastFailureTime = time.Now().Unix()
+	p.Diagnostics.Failures[category]++
+}
+
+// SetBlockProbe sets the result of requesting blocks from the peer
+func (p *Peer) SetBlockProbe(servesBlocks, headVerified bool, earliestSlot uint64) {
+	p.BlockProbe = &BlockProbe{
+		ServesBlocks: servesBlocks,
+		HeadVerified: headVerified,
+		EarliestSlot: earliestSlot,
+		Time:         time.Now().Unix(),
+	}
+}
+
+// SetTransport sets the transport of the last successful connection
+func (p *Peer) SetTransport(transport string) {
+	p.Transport = transport
+}
+
 // SetConnectionStatus sets connection status and date
 func (p *Peer) SetConnectionStatus(status bool) {
 	p.IsConnectable = status
@@ -270,20 +584,44 @@ func (p *Peer) SetConnectionStatus(status bool) {
 	}
 }
 
-// SetSyncStatus sets the sync status of a peer
-func (p *Peer) SetSyncStatus(block int64) {
-	cb := util.CurrentBlock()
-	if cb-block <= blockIgnoreThreshold {
-		p.Sync = &Sync{
-			Status:   true,
-			Distance: 0,
-		}
-	} else {
-		p.Sync = &Sync{
-			Status:   false,
-			Distance: int(((cb - block) * 100) / cb),
+// SetSyncStatus sets the sync status of a peer against the wall-clock slot of its network
+// and against the majority status of the network, nil when it isn't known yet
+func (p *Peer) SetSyncStatus(status *common.Status, currentSlot int64, majority *common.Status, thresholds *SyncThresholds) {
+	sync := &Sync{
+		HeadSlot:       int64(status.HeadSlot),
+		FinalizedEpoch: int64(status.FinalizedEpoch),
+		Distance:       currentSlot - int64(status.HeadSlot),
+	}
+	// the peer is classified on the farther of the wall-clock slot and the majority head
+	distance := sync.Distance
+	if majority != nil {
+		sync.ObservedDistance = int64(majority.HeadSlot) - int64(status.HeadSlot)
+		sync.FinalizedLag = int64(majority.FinalizedEpoch) - int64(status.FinalizedEpoch)
+		if sync.ObservedDistance > distance {
+			distance = sync.ObservedDistance
 		}
 	}
+
+	switch {
+	case distance <= thresholds.SyncedSlots && sync.FinalizedLag <= thresholds.FinalizedLagEpochs:
+		sync.State = StatusSynced
+	case distance <= thresholds.BehindSlots:
+		sync.State = StatusBehind
+	default:
+		sync.State = StatusStalled
+	}
+	sync.Status = sync.State == StatusSynced
+	p.Sync = sync
+}
+
+// SetChainStatus sets the chain status announced by the peer
+func (p *Peer) SetChainStatus(status *common.Status) {
+	p.ChainStatus = &ChainStatus{
+		FinalizedRoot:  status.FinalizedRoot.String(),
+		FinalizedEpoch: uint64(status.FinalizedEpoch),
+		HeadRoot:       status.HeadRoot.String(),
+		HeadSlot:       uint64(status.HeadSlot),
+	}
 }
 
 // SetGeoLocation sets the geolocation information
@@ -295,7 +633,10 @@ func (p *Peer) SetGeoLocation(geoLocation *GeoLocation) {
 func (p *Peer) GetPeerInfo() *peer.AddrInfo {
 	maddrs := make([]ma.Multiaddr, 0)
 	for _, v := range p.Addrs {
-		madd, _ := ma.NewMultiaddr(v)
+		madd, err := ma.NewMultiaddr(v)
+		if err != nil {
+			continue
+		}
 		maddrs = append(maddrs, madd)
 	}
 	return &peer.AddrInfo{
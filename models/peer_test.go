@@ -0,0 +1,165 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	ic "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetSyncStatus(t *testing.T) {
+	thresholds := &SyncThresholds{SyncedSlots: 2, BehindSlots: 64, FinalizedLagEpochs: 2}
+	majority := &common.Status{HeadSlot: 1000, FinalizedEpoch: 29}
+	tests := []struct {
+		name     string
+		status   *common.Status
+		majority *common.Status
+		// currentSlot is the wall-clock slot, the majority head when zero
+		currentSlot int64
+		state       string
+		distance    int64
+	}{
+		{
+			name:     "at the majority head",
+			status:   &common.Status{HeadSlot: 1000, FinalizedEpoch: 29},
+			majority: majority,
+			state:    StatusSynced,
+			distance: 0,
+		},
+		{
+			name:     "within the synced threshold",
+			status:   &common.Status{HeadSlot: 998, FinalizedEpoch: 29},
+			majority: majority,
+			state:    StatusSynced,
+			distance: 2,
+		},
+		{
+			name:     "ahead of the majority",
+			status:   &common.Status{HeadSlot: 1001, FinalizedEpoch: 29},
+			majority: majority,
+			state:    StatusSynced,
+			distance: -1,
+		},
+		{
+			name:     "finalized checkpoint lagging",
+			status:   &common.Status{HeadSlot: 1000, FinalizedEpoch: 26},
+			majority: majority,
+			state:    StatusBehind,
+			distance: 0,
+		},
+		{
+			name:     "catching up",
+			status:   &common.Status{HeadSlot: 936, FinalizedEpoch: 27},
+			majority: majority,
+			state:    StatusBehind,
+			distance: 64,
+		},
+		{
+			name:     "stalled",
+			status:   &common.Status{HeadSlot: 935, FinalizedEpoch: 27},
+			majority: majority,
+			state:    StatusStalled,
+			distance: 65,
+		},
+		{
+			name:        "majority behind the wall clock",
+			status:      &common.Status{HeadSlot: 1000, FinalizedEpoch: 29},
+			majority:    majority,
+			currentSlot: 1010,
+			state:       StatusBehind,
+			distance:    0,
+		},
+		{
+			name:   "wall clock without a majority",
+			status: &common.Status{HeadSlot: 990, FinalizedEpoch: 10},
+			state:  StatusBehind,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			currentSlot := tt.currentSlot
+			if currentSlot == 0 {
+				currentSlot = 1000
+			}
+			p := &Peer{}
+			p.SetSyncStatus(tt.status, currentSlot, tt.majority, thresholds)
+			assert.Equal(t, tt.state, p.Sync.State)
+			assert.Equal(t, tt.state == StatusSynced, p.Sync.Status)
+			assert.Equal(t, currentSlot-int64(tt.status.HeadSlot), p.Sync.Distance)
+			if tt.majority != nil {
+				assert.Equal(t, tt.distance, p.Sync.ObservedDistance)
+			}
+		})
+	}
+}
+
+func TestNewENREntries(t *testing.T) {
+	entries := NewENREntries(map[string]string{
+		"udp":      "0x2329",
+		"eth2":     "0x4a26c58b",
+		"client.v": "0x01",
+		"$x":       "0x02",
+	})
+	assert.Equal(t, []*ENREntry{
+		{Key: "$x", Value: "0x02"},
+		{Key: "client.v", Value: "0x01"},
+		{Key: "eth2", Value: "0x4a26c58b"},
+		{Key: "udp", Value: "0x2329"},
+	}, entries)
+	assert.Empty(t, NewENREntries(nil))
+}
+
+func TestRecordFailure(t *testing.T) {
+	p := &Peer{}
+	p.RecordFailure(FailureDialTimeout, errors.New("i/o timeout"))
+	assert.Equal(t, "i/o timeout", p.Diagnostics.LastError)
+
+	p.RecordFailure(FailureOther, nil)
+	assert.Equal(t, FailureOther, p.Diagnostics.LastFailure)
+	assert.Empty(t, p.Diagnostics.LastError)
+	assert.Equal(t, map[string]int64{FailureDialTimeout: 1, FailureOther: 1}, p.Diagnostics.Failures)
+}
+
+func TestNewInboundPeer(t *testing.T) {
+	_, pub, err := ic.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	v4 := ma.StringCast("/ip4/1.2.3.4/tcp/9000")
+	v6 := ma.StringCast("/ip6/2001:db8::1/tcp/9001")
+
+	tests := []struct {
+		name     string
+		addrs    []ma.Multiaddr
+		ip       string
+		tcpPort  int
+		ip6      string
+		tcp6Port int
+	}{
+		{name: "ipv4", addrs: []ma.Multiaddr{v4}, ip: "1.2.3.4", tcpPort: 9000},
+		{name: "ipv6 first", addrs: []ma.Multiaddr{v6, v4}, ip: "1.2.3.4", tcpPort: 9000, ip6: "2001:db8::1", tcp6Port: 9001},
+		{name: "ipv6 only", addrs: []ma.Multiaddr{v6}, ip: "2001:db8::1", tcpPort: 9001, ip6: "2001:db8::1", tcp6Port: 9001},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := NewInboundPeer(id, tt.addrs, &common.Eth2Data{}, "mainnet")
+			require.NoError(t, err)
+			assert.Equal(t, tt.ip, p.IP)
+			assert.Equal(t, tt.tcpPort, p.TCPPort)
+			assert.Equal(t, tt.ip6, p.IP6)
+			assert.Equal(t, tt.tcp6Port, p.TCP6Port)
+		})
+	}
+
+	_, err = NewInboundPeer(id, []ma.Multiaddr{ma.StringCast("/ip4/1.2.3.4/udp/9000")}, &common.Eth2Data{}, "mainnet")
+	assert.Error(t, err)
+}
@@ -0,0 +1,36 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+// Propagation holds the gossip messages the peer delivered first to the crawler.
+// Latencies are summed arrival times relative to the start of the message slot, in milliseconds.
+type Propagation struct {
+	Blocks           int64 `json:"blocks" bson:"blocks"`
+	BlockLatency     int64 `json:"block_latency" bson:"block_latency"`
+	Aggregates       int64 `json:"aggregates" bson:"aggregates"`
+	AggregateLatency int64 `json:"aggregate_latency" bson:"aggregate_latency"`
+}
+
+// MeanBlockLatency returns the mean arrival latency of the blocks in milliseconds
+func (p *Propagation) MeanBlockLatency() float64 {
+	if p.Blocks == 0 {
+		return 0
+	}
+	return float64(p.BlockLatency) / float64(p.Blocks)
+}
+
+// MeanAggregateLatency returns the mean arrival latency of the aggregates in milliseconds
+func (p *Propagation) MeanAggregateLatency() float64 {
+	if p.Aggregates == 0 {
+		return 0
+	}
+	return float64(p.AggregateLatency) / float64(p.Aggregates)
+}
+
+// ClientPropagation represents the propagation stats of the peers of a client
+type ClientPropagation struct {
+	Client string `json:"client"`
+	Peers  int    `json:"peers"`
+	Propagation
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Snapshot holds the results of the aggregations of the peers of a network at a time
+type Snapshot struct {
+	ID      uuid.UUID `json:"id" bson:"_id"`
+	Time    int64     `json:"time" bson:"time"`
+	Network string    `json:"network" bson:"network"`
+	// Bucket is the scheduled time the snapshot was taken for, there is a single snapshot per bucket
+	Bucket int64 `json:"bucket,omitempty" bson:"bucket,omitempty"`
+
+	AgentName           []*AggregateData            `json:"agent_name" bson:"agent_name"`
+	Country             []*AggregateData            `json:"country" bson:"country"`
+	OperatingSystem     []*AggregateData            `json:"operating_system" bson:"operating_system"`
+	NetworkType         []*AggregateData            `json:"network_type" bson:"network_type"`
+	ClientVersion       []*ClientVersionAggregation `json:"client_version" bson:"client_version"`
+	Fork                []*ForkAggregation          `json:"fork" bson:"fork"`
+	GoodbyeReason       []*AggregateData            `json:"goodbye_reason" bson:"goodbye_reason"`
+	Failure             []*AggregateData            `json:"failure" bson:"failure"`
+	PeerState           []*AggregateData            `json:"peer_state" bson:"peer_state"`
+	SyncState           []*AggregateData            `json:"sync_state" bson:"sync_state"`
+	ENRKey              []*AggregateData            `json:"enr_key" bson:"enr_key"`
+	IPVersion           []*AggregateData            `json:"ip_version" bson:"ip_version"`
+	QUICAdoption        []*QUICAdoption             `json:"quic_adoption" bson:"quic_adoption"`
+	DiscoveredENRKeys   []*AggregateData            `json:"discovered_enr_keys" bson:"discovered_enr_keys"`
+	UptimeByClient      []*UptimeDistribution       `json:"uptime_by_client" bson:"uptime_by_client"`
+	UptimeByProvider    []*UptimeDistribution       `json:"uptime_by_provider" bson:"uptime_by_provider"`
+	Subnets             *SubnetCounts               `json:"subnets" bson:"subnets"`
+	FinalizedCheckpoint []*CheckpointAggregation    `json:"finalized_checkpoint" bson:"finalized_checkpoint"`
+	ClientPropagation   []*ClientPropagation        `json:"client_propagation" bson:"client_propagation"`
+}
+
+// NewSnapshot initializes an empty snapshot of the network taken now for the bucket
+func NewSnapshot(network string, bucket int64) *Snapshot {
+	return &Snapshot{
+		ID:      uuid.New(),
+		Time:    time.Now().Unix(),
+		Bucket:  bucket,
+		Network: network,
+	}
+}
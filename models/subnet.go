@@ -0,0 +1,44 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"eth2-crawler/crawler/util"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+)
+
+// SubnetCounts holds the number of peers subscribed to every attestation and sync committee subnet
+type SubnetCounts struct {
+	Total    int   `json:"total" bson:"total"`
+	Attnets  []int `json:"attnets" bson:"attnets"`
+	Syncnets []int `json:"syncnets" bson:"syncnets"`
+}
+
+// CountSubnets counts the peers subscribed to every subnet.
+// The sync committee subnets are only known from the metadata of the peers.
+func CountSubnets(peers []*Peer) *SubnetCounts {
+	counts := &SubnetCounts{
+		Total:    len(peers),
+		Attnets:  make([]int, len(common.AttnetBits{})*8),
+		Syncnets: make([]int, util.SyncnetBits{}.BitLen()),
+	}
+	for _, p := range peers {
+		subscribed := p.SubscribedAttnets()
+		for i := range counts.Attnets {
+			if subscribed[i/8]&(1<<(i%8)) != 0 {
+				counts.Attnets[i]++
+			}
+		}
+		if p.Metadata == nil {
+			continue
+		}
+		for i := range counts.Syncnets {
+			if p.Metadata.Syncnets.IsSet(uint64(i)) {
+				counts.Syncnets[i]++
+			}
+		}
+	}
+	return counts
+}
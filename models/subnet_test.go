@@ -0,0 +1,26 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+
+	"github.com/protolambda/zrnt/eth2/beacon/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountSubnets(t *testing.T) {
+	peers := []*Peer{
+		// the record only, the sync committee subnets are unknown
+		{Attnets: common.AttnetBits{0x01}},
+		// the metadata is preferred over the record
+		{Attnets: common.AttnetBits{0x01}, Metadata: &Metadata{Attnets: common.AttnetBits{0x02}, Syncnets: [1]byte{0x09}}},
+		{Metadata: &Metadata{Attnets: common.AttnetBits{0x03}, Syncnets: [1]byte{0x01}}},
+	}
+	counts := CountSubnets(peers)
+	assert.Equal(t, 3, counts.Total)
+	assert.Len(t, counts.Attnets, 64)
+	assert.Equal(t, []int{2, 2, 0}, counts.Attnets[:3])
+	assert.Equal(t, []int{2, 0, 0, 1}, counts.Syncnets)
+}
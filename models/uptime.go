@@ -0,0 +1,101 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// uptime windows of the peers
+const (
+	UptimeDay   = 24 * time.Hour
+	UptimeWeek  = 7 * UptimeDay
+	UptimeMonth = 30 * UptimeDay
+)
+
+// Check is the outcome of an update of a peer
+type Check struct {
+	Time    time.Time `json:"time" bson:"time"`
+	PeerID  peer.ID   `json:"peer_id" bson:"peer_id"`
+	Network string    `json:"network" bson:"network"`
+	Success bool      `json:"success" bson:"success"`
+	// Failure is the failure category of the last attempt of a failed update
+	Failure string `json:"failure,omitempty" bson:"failure,omitempty"`
+}
+
+// NewCheck initializes the outcome of the update of the peer that just completed
+func NewCheck(p *Peer, success bool) *Check {
+	c := &Check{
+		Time:    time.Now(),
+		PeerID:  p.ID,
+		Network: p.Network,
+		Success: success,
+	}
+	if !success && p.Diagnostics != nil {
+		c.Failure = p.Diagnostics.LastFailure
+	}
+	return c
+}
+
+// Uptime holds the percentage of time a peer was up over the last day, week and month,
+// computed from the outcome of its updates in each window. The outcome of an update holds until
+// the next update, each update is weighted by the time until the next one. An outcome holds at
+// most as long as a reachable peer waits for its next update, the time past it isn't observed.
+// A window is nil until the peer was observed in it.
+type Uptime struct {
+	Day   *float64 `json:"day" bson:"day"`
+	Week  *float64 `json:"week" bson:"week"`
+	Month *float64 `json:"month" bson:"month"`
+}
+
+var uptimeWindows = [3]time.Duration{UptimeDay, UptimeWeek, UptimeMonth}
+
+// UptimeDistribution counts the peers of a client or hosting provider by weekly uptime
+type UptimeDistribution struct {
+	Name  string  `json:"name"`
+	Peers int     `json:"peers"`
+	Mean  float64 `json:"mean"`
+	// Low peers are up less than 50% of the time, Medium less than 90%, High less than 99%
+	Low    int `json:"low"`
+	Medium int `json:"medium"`
+	High   int `json:"high"`
+	Full   int `json:"full"`
+}
+
+// NewUptime computes the uptime at the given time from the outcome of the updates of the peer,
+// the oldest first. The outcome of an update holds at most for the given time.
+func NewUptime(checks []*Check, now time.Time, hold time.Duration) *Uptime {
+	u := new(Uptime)
+	percentages := [3]**float64{&u.Day, &u.Week, &u.Month}
+	for i, window := range uptimeWindows {
+		start := now.Add(-window)
+		var up, observed time.Duration
+		for j := 0; j+1 < len(checks); j++ {
+			from, to := checks[j].Time, checks[j+1].Time
+			if to.Sub(from) > hold {
+				to = from.Add(hold)
+			}
+			if from.Before(start) {
+				from = start
+			}
+			if to.After(now) {
+				to = now
+			}
+			if !to.After(from) {
+				continue
+			}
+			observed += to.Sub(from)
+			if checks[j].Success {
+				up += to.Sub(from)
+			}
+		}
+		if observed > 0 {
+			uptime := 100 * float64(up) / float64(observed)
+			*percentages[i] = &uptime
+		}
+	}
+	return u
+}
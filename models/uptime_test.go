@@ -0,0 +1,119 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type check struct {
+	after   time.Duration
+	success bool
+}
+
+// checks returns the outcomes, each one the given time after the previous one, and the time of the last one
+func checks(start time.Time, outcomes []check) ([]*Check, time.Time) {
+	at := start
+	result := make([]*Check, 0, len(outcomes))
+	for _, c := range outcomes {
+		at = at.Add(c.after)
+		result = append(result, &Check{Time: at, Success: c.success})
+	}
+	return result, at
+}
+
+func TestNewUptime(t *testing.T) {
+	start := time.Unix(1600000000, 0)
+	tests := []struct {
+		name     string
+		outcomes []check
+		day      float64
+	}{
+		{
+			name:     "always up",
+			outcomes: []check{{0, true}, {time.Hour, true}, {time.Hour, true}},
+			day:      100,
+		},
+		{
+			name:     "always down",
+			outcomes: []check{{0, false}, {time.Hour, false}, {time.Hour, true}},
+			day:      0,
+		},
+		{
+			// the last outcome holds until the next update, it isn't counted yet
+			name:     "down at the last update",
+			outcomes: []check{{0, true}, {time.Hour, false}},
+			day:      100,
+		},
+		{
+			// an hour up and an hour down weigh the same, whatever the number of updates
+			name: "weighted by time",
+			outcomes: []check{
+				{0, true}, {10 * time.Minute, true}, {10 * time.Minute, true}, {10 * time.Minute, true},
+				{10 * time.Minute, true}, {10 * time.Minute, true}, {10 * time.Minute, false},
+				{time.Hour, true},
+			},
+			day: 50,
+		},
+		{
+			// a short success between long failures is a short uptime, not half of it
+			name:     "short success",
+			outcomes: []check{{0, false}, {23 * time.Hour, true}, {time.Hour, false}},
+			day:      100.0 / 24,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, now := checks(start, tt.outcomes)
+			u := NewUptime(c, now, UptimeDay)
+			require.NotNil(t, u.Day)
+			require.NotNil(t, u.Week)
+			require.NotNil(t, u.Month)
+			assert.InDelta(t, tt.day, *u.Day, 1e-6)
+			// all the updates are in the day, the longer windows observed the same time
+			assert.InDelta(t, *u.Day, *u.Month, 1e-6)
+		})
+	}
+}
+
+func TestNewUptimeFirst(t *testing.T) {
+	c, now := checks(time.Unix(1600000000, 0), []check{{0, true}})
+	u := NewUptime(c, now, UptimeDay)
+	assert.Nil(t, u.Day)
+	assert.Nil(t, u.Week)
+	assert.Nil(t, u.Month)
+	assert.Nil(t, NewUptime(nil, now, UptimeDay).Month)
+}
+
+func TestNewUptimeWindows(t *testing.T) {
+	// up for a month, then down for two days
+	outcomes := []check{{0, true}}
+	for i := 0; i < 29; i++ {
+		outcomes = append(outcomes, check{UptimeDay, true})
+	}
+	outcomes[len(outcomes)-1].success = false
+	outcomes = append(outcomes, check{UptimeDay, false}, check{UptimeDay, true})
+	c, now := checks(time.Unix(1600000000, 0), outcomes)
+	u := NewUptime(c, now, 2*UptimeDay)
+
+	// the time before the window isn't counted
+	assert.InDelta(t, 0, *u.Day, 1e-6)
+	assert.InDelta(t, 100*5.0/7, *u.Week, 1e-6)
+	assert.InDelta(t, 100*28.0/30, *u.Month, 1e-6)
+}
+
+func TestNewUptimeHold(t *testing.T) {
+	// a failed peer is updated again a week later, the failure only holds for a day
+	c, now := checks(time.Unix(1600000000, 0), []check{
+		{0, true}, {UptimeDay, false}, {UptimeWeek, true},
+	})
+	u := NewUptime(c, now, UptimeDay)
+	assert.Nil(t, u.Day)
+	assert.InDelta(t, 0, *u.Week, 1e-6)
+	assert.InDelta(t, 50, *u.Month, 1e-6)
+}
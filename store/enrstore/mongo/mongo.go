@@ -0,0 +1,84 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package mongo implements all the store methods
+package mongo
+
+import (
+	"context"
+	"eth2-crawler/models"
+	"eth2-crawler/store/enrstore"
+	"eth2-crawler/utils/config"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+type mongoStore struct {
+	client  *mongo.Client
+	coll    *mongo.Collection
+	timeout time.Duration
+}
+
+// New creates new instance of ENR Store based on MongoDB
+func New(cfg *config.Database) (enrstore.Provider, error) {
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	opts := options.Client()
+
+	opts.ApplyURI(cfg.URI)
+	client, err := mongo.NewClient(opts)
+	if err != nil {
+		return nil, fmt.Errorf("connecton error [%s]: %w", opts.GetURI(), err)
+	}
+
+	// connect to the mongoDB cluster
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err = client.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// test the connection
+	err = client.Ping(ctx, readpref.Primary())
+	if err != nil {
+		return nil, err
+	}
+
+	return &mongoStore{
+		client:  client,
+		coll:    client.Database(cfg.Database).Collection(cfg.ENRCollection),
+		timeout: timeout,
+	}, nil
+}
+
+func (s mongoStore) Create(ctx context.Context, record *models.ENRRecord) error {
+	_, err := s.coll.InsertOne(ctx, record, options.InsertOne())
+	return err
+}
+
+// List returns the previous records of the peer, the latest first
+func (s mongoStore) List(ctx context.Context, peerID peer.ID) ([]*models.ENRRecord, error) {
+	filter := bson.D{{Key: "peer_id", Value: peerID}}
+	cursor, err := s.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "seq", Value: -1}}))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.ENRRecord, 0)
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(models.ENRRecord)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package mongo implements all the store methods
+package mongo
+
+import (
+	"context"
+	"eth2-crawler/models"
+	"eth2-crawler/store/nodestore"
+	"eth2-crawler/utils/config"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+type mongoStore struct {
+	client  *mongo.Client
+	coll    *mongo.Collection
+	timeout time.Duration
+}
+
+// New creates new instance of Node Store based on MongoDB
+func New(cfg *config.Database) (nodestore.Provider, error) {
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	opts := options.Client()
+
+	opts.ApplyURI(cfg.URI)
+	client, err := mongo.NewClient(opts)
+	if err != nil {
+		return nil, fmt.Errorf("connecton error [%s]: %w", opts.GetURI(), err)
+	}
+
+	// connect to the mongoDB cluster
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err = client.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// test the connection
+	err = client.Ping(ctx, readpref.Primary())
+	if err != nil {
+		return nil, err
+	}
+
+	return &mongoStore{
+		client:  client,
+		coll:    client.Database(cfg.Database).Collection(cfg.NodeCollection),
+		timeout: timeout,
+	}, nil
+}
+
+// Upsert stores the node, the first seen time of a known node is kept
+func (s mongoStore) Upsert(ctx context.Context, node *models.DiscoveredNode) error {
+	filter := bson.D{{Key: "_id", Value: node.ID}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "network", Value: node.Network},
+			{Key: "kind", Value: node.Kind},
+			{Key: "seq", Value: node.Seq},
+			{Key: "ip", Value: node.IP},
+			{Key: "enr_keys", Value: node.ENRKeys},
+			{Key: "fork_digest", Value: node.ForkDigest},
+			{Key: "last_seen", Value: node.LastSeen},
+		}},
+		{Key: "$setOnInsert", Value: bson.D{
+			{Key: "first_seen", Value: node.FirstSeen},
+		}},
+	}
+	_, err := s.coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// seenFilter matches the nodes of a network seen since the given time
+func seenFilter(network string, since int64) bson.D {
+	return bson.D{
+		{Key: "network", Value: network},
+		{Key: "last_seen", Value: bson.D{{Key: "$gte", Value: since}}},
+	}
+}
+
+type aggregateData struct {
+	ID    string `json:"_id" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
+
+// AggregateByKind counts the nodes seen since the given time by kind
+func (s mongoStore) AggregateByKind(ctx context.Context, network string, since int64) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: seenFilter(network, since)},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$kind"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	return s.aggregate(ctx, query)
+}
+
+// AggregateByENRKeys counts the nodes without an eth2 entry seen since the given time by the set of keys of their record
+func (s mongoStore) AggregateByENRKeys(ctx context.Context, network string, since int64) ([]*models.AggregateData, error) {
+	match := seenFilter(network, since)
+	match = append(match, bson.E{Key: "kind", Value: models.NodeKindOther})
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: match},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				// the keys are sorted when stored
+				{Key: "_id", Value: bson.D{{Key: "$reduce", Value: bson.D{
+					{Key: "input", Value: "$enr_keys"},
+					{Key: "initialValue", Value: ""},
+					{Key: "in", Value: bson.D{{Key: "$cond", Value: bson.A{
+						bson.D{{Key: "$eq", Value: bson.A{"$$value", ""}}},
+						"$$this",
+						bson.D{{Key: "$concat", Value: bson.A{"$$value", ",", "$$this"}}},
+					}}}},
+				}}}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	return s.aggregate(ctx, query)
+}
+
+func (s mongoStore) aggregate(ctx context.Context, query mongo.Pipeline) ([]*models.AggregateData, error) {
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
@@ -0,0 +1,18 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package nodestore implements db for the nodes seen in discovery
+package nodestore
+
+import (
+	"context"
+
+	"eth2-crawler/models"
+)
+
+// Provider represents store provider interface that can be implemented by different DB engines
+type Provider interface {
+	Upsert(ctx context.Context, node *models.DiscoveredNode) error
+	AggregateByKind(ctx context.Context, network string, since int64) ([]*models.AggregateData, error)
+	AggregateByENRKeys(ctx context.Context, network string, since int64) ([]*models.AggregateData, error)
+}
@@ -15,7 +15,7 @@ import (
 
 	"eth2-crawler/utils/config"
 
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -23,9 +23,10 @@ import (
 )
 
 type mongoStore struct {
-	client  *mongo.Client
-	coll    *mongo.Collection
-	timeout time.Duration
+	client      *mongo.Client
+	coll        *mongo.Collection
+	transitions *mongo.Collection
+	timeout     time.Duration
 }
 
 func (s *mongoStore) Upsert(ctx context.Context, peer *models.Peer) error {
@@ -56,13 +57,130 @@ func (s *mongoStore) Update(ctx context.Context, peer *models.Peer) error {
 	filter := bson.D{
 		{Key: "_id", Value: peer.ID},
 	}
-	_, err := s.coll.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: peer}})
+	doc, err := toDocument(peer)
+	if err != nil {
+		return err
+	}
+	// the peer may have been loaded before its node record was updated
+	update := bson.D{}
+	for _, e := range doc {
+		if !isENRKey(e.Key) {
+			update = append(update, e)
+		}
+	}
+	_, err = s.coll.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: update}})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// enrKeys are the fields set from the node record, they are only written by UpdateENR
+var enrKeys = []string{
+	"ip", "tcp_port", "udp_port", "ip6", "tcp6_port", "udp6_port", "quic_port", "quic6_port", "addrs", "enr_seq", "enr", "enr_entries", "attnets",
+	"fork_digest", "next_fork_version", "next_fork_epoch", "fork_name", "next_fork_name", "unknown_digest",
+}
+
+// UpdateENR replaces the node record fields of the peer when its record has a higher sequence number.
+// It returns the peer as it was before the update, or nil when the stored record is not older.
+func (s *mongoStore) UpdateENR(ctx context.Context, peer *models.Peer) (*models.Peer, error) {
+	doc, err := toDocument(peer)
+	if err != nil {
+		return nil, err
+	}
+	// the update is a pipeline to compare the record with the stored one, its values are literals
+	update := bson.D{}
+	for _, e := range doc {
+		if isENRKey(e.Key) {
+			update = append(update, bson.E{Key: e.Key, Value: bson.D{{Key: "$literal", Value: e.Value}}})
+		}
+	}
+	// the location is resolved again when one of the ips changed, peers stored before IPv6 support have no ip6
+	update = append(update, bson.E{Key: "geo_location", Value: bson.D{{Key: "$cond", Value: bson.A{
+		bson.D{{Key: "$and", Value: bson.A{
+			bson.D{{Key: "$eq", Value: bson.A{"$ip", bson.D{{Key: "$literal", Value: peer.IP}}}}},
+			bson.D{{Key: "$eq", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$ip6", ""}}}, bson.D{{Key: "$literal", Value: peer.IP6}}}}},
+		}}},
+		"$geo_location",
+		nil,
+	}}}})
+	// a peer moving to an unknown fork digest isn't dialed anymore
+	if peer.UnknownDigest {
+		update = append(update, bson.E{Key: "is_connectable", Value: false})
+	}
+
+	filter := bson.D{
+		{Key: "_id", Value: peer.ID},
+		// peers stored before the sequence number was tracked have none
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "enr_seq", Value: bson.D{{Key: "$lt", Value: peer.ENRSeq}}}},
+			bson.D{{Key: "enr_seq", Value: bson.D{{Key: "$exists", Value: false}}}},
+		}},
+	}
+	previous := new(models.Peer)
+	err = s.coll.FindOneAndUpdate(ctx, filter, mongo.Pipeline{{{Key: "$set", Value: update}}},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before)).Decode(previous)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return previous, nil
+}
+
+// Recheck makes a retired peer due for one more update, it stays retired unless the update succeeds.
+// The peer is left alone while a recheck is pending or its backoff has not elapsed,
+// so that a retired peer found over and over is not updated more often than its schedule allows.
+func (s *mongoStore) Recheck(ctx context.Context, peerID peer.ID) error {
+	filter := bson.D{
+		{Key: "_id", Value: peerID},
+		{Key: "state", Value: models.PeerStateRetired},
+		{Key: "recheck", Value: bson.D{{Key: "$ne", Value: true}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "next_check_at", Value: bson.D{{Key: "$lte", Value: time.Now().Unix()}}}},
+			bson.D{{Key: "next_check_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+		}},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "recheck", Value: true},
+		}},
+	}
+	_, err := s.coll.UpdateOne(ctx, filter, update)
+	return err
+}
+
+func isENRKey(key string) bool {
+	for _, k := range enrKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// toDocument converts the peer to a document without the propagation counters,
+// they are only incremented by IncPropagation
+func toDocument(peer *models.Peer) (bson.D, error) {
+	data, err := bson.Marshal(peer)
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.D
+	err = bson.Unmarshal(data, &doc)
+	if err != nil {
+		return nil, err
+	}
+	result := doc[:0]
+	for _, e := range doc {
+		if e.Key != "propagation" {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
 func (s *mongoStore) Delete(ctx context.Context, peer *models.Peer) error {
 	filter := bson.D{
 		{Key: "_id", Value: peer.ID},
@@ -74,6 +192,13 @@ func (s *mongoStore) Delete(ctx context.Context, peer *models.Peer) error {
 	return nil
 }
 
+// BackfillNetwork tags the peers stored before the network profiles with the network
+func (s *mongoStore) BackfillNetwork(ctx context.Context, network string) error {
+	filter := bson.D{{Key: "network", Value: bson.D{{Key: "$exists", Value: false}}}}
+	_, err := s.coll.UpdateMany(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "network", Value: network}}}})
+	return err
+}
+
 func (s *mongoStore) View(ctx context.Context, peerID peer.ID) (*models.Peer, error) {
 	filter := bson.D{
 		{Key: "_id", Value: peerID},
@@ -91,9 +216,9 @@ func (s *mongoStore) View(ctx context.Context, peerID peer.ID) (*models.Peer, er
 }
 
 // Todo: accept filter and find options to get limited information
-func (s *mongoStore) ViewAll(ctx context.Context) ([]*models.Peer, error) {
+func (s *mongoStore) ViewAll(ctx context.Context, network string) ([]*models.Peer, error) {
 	var peers []*models.Peer
-	cursor, err := s.coll.Find(ctx, bson.D{{Key: "is_connectable", Value: true}})
+	cursor, err := s.coll.Find(ctx, connectableFilter(network))
 	if err != nil {
 		return nil, err
 	}
@@ -111,13 +236,29 @@ func (s *mongoStore) ViewAll(ctx context.Context) ([]*models.Peer, error) {
 	return peers, nil
 }
 
-func (s *mongoStore) ListForJob(ctx context.Context, lastUpdated time.Duration, limit int) ([]*models.Peer, error) {
+// ListForJob returns the peers due for an update, the most overdue first.
+// Retired peers are skipped unless they were found again.
+// Peers stored before the schedule was tracked have no next check time and are due.
+func (s *mongoStore) ListForJob(ctx context.Context, network string, limit int) ([]*models.Peer, error) {
 	var peers []*models.Peer
-	timeToSkip := time.Now().Add(-lastUpdated).Unix()
 	opts := options.Find()
 	opts.SetLimit(int64(limit))
-	opts.SetSort(bson.D{{Key: "last_updated", Value: 1}})
-	filter := bson.D{{Key: "last_updated", Value: bson.D{{Key: "$lt", Value: timeToSkip}}}}
+	opts.SetSort(bson.D{{Key: "next_check_at", Value: 1}})
+	filter := bson.D{
+		{Key: "network", Value: network},
+		// peers on unknown fork digests belong to another network
+		{Key: "unknown_digest", Value: bson.D{{Key: "$ne", Value: true}}},
+		{Key: "$and", Value: bson.A{
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "state", Value: bson.D{{Key: "$ne", Value: models.PeerStateRetired}}}},
+				bson.D{{Key: "recheck", Value: true}},
+			}}},
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "next_check_at", Value: bson.D{{Key: "$lte", Value: time.Now().Unix()}}}},
+				bson.D{{Key: "next_check_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+			}}},
+		}},
+	}
 	cursor, err := s.coll.Find(ctx, filter, opts)
 	if err != nil {
 		return nil, err
@@ -136,17 +277,23 @@ func (s *mongoStore) ListForJob(ctx context.Context, lastUpdated time.Duration,
 	return peers, nil
 }
 
+// connectableFilter matches the connectable peers of a network
+func connectableFilter(network string) bson.D {
+	return bson.D{
+		{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
+		{Key: "network", Value: network},
+	}
+}
+
 type aggregateData struct {
 	ID    string `json:"_id" bson:"_id"`
 	Count int    `json:"count" bson:"count"`
 }
 
-func (s *mongoStore) AggregateByAgentName(ctx context.Context) ([]*models.AggregateData, error) {
+func (s *mongoStore) AggregateByAgentName(ctx context.Context, network string) ([]*models.AggregateData, error) {
 	query := mongo.Pipeline{
 		bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
-			}},
+			{Key: "$match", Value: connectableFilter(network)},
 		},
 
 		bson.D{
@@ -182,12 +329,10 @@ type clientVersionAggregation struct {
 	Versions []*models.AggregateData `json:"versions" bson:"versions"`
 }
 
-func (s *mongoStore) AggregateByClientVersion(ctx context.Context) ([]*models.ClientVersionAggregation, error) {
+func (s *mongoStore) AggregateByClientVersion(ctx context.Context, network string) ([]*models.ClientVersionAggregation, error) {
 	query := mongo.Pipeline{
 		bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
-			}},
+			{Key: "$match", Value: connectableFilter(network)},
 		},
 
 		bson.D{
@@ -239,12 +384,10 @@ func (s *mongoStore) AggregateByClientVersion(ctx context.Context) ([]*models.Cl
 	return result, nil
 }
 
-func (s *mongoStore) AggregateByOperatingSystem(ctx context.Context) ([]*models.AggregateData, error) {
+func (s *mongoStore) AggregateByOperatingSystem(ctx context.Context, network string) ([]*models.AggregateData, error) {
 	query := mongo.Pipeline{
 		bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
-			}},
+			{Key: "$match", Value: connectableFilter(network)},
 		},
 
 		bson.D{
@@ -273,12 +416,10 @@ func (s *mongoStore) AggregateByOperatingSystem(ctx context.Context) ([]*models.
 	return result, nil
 }
 
-func (s *mongoStore) AggregateByCountry(ctx context.Context) ([]*models.AggregateData, error) {
+func (s *mongoStore) AggregateByCountry(ctx context.Context, network string) ([]*models.AggregateData, error) {
 	query := mongo.Pipeline{
 		bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
-			}},
+			{Key: "$match", Value: connectableFilter(network)},
 		},
 
 		bson.D{
@@ -307,13 +448,13 @@ func (s *mongoStore) AggregateByCountry(ctx context.Context) ([]*models.Aggregat
 	return result, nil
 }
 
-func (s *mongoStore) AggregateByNetworkType(ctx context.Context) ([]*models.AggregateData, error) {
+func (s *mongoStore) AggregateByNetworkType(ctx context.Context, network string) ([]*models.AggregateData, error) {
 	query := mongo.Pipeline{
 		bson.D{
 			// avoid aggregation of entries without geolocation information
 			{Key: "$match", Value: bson.D{
 				{Key: "$and", Value: bson.A{
-					bson.D{{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}}},
+					connectableFilter(network),
 					bson.D{{Key: "geo_location", Value: bson.D{{Key: "$ne", Value: nil}}}},
 				}},
 			}},
@@ -344,6 +485,690 @@ func (s *mongoStore) AggregateByNetworkType(ctx context.Context) ([]*models.Aggr
 	return result, nil
 }
 
+type forkAggregation struct {
+	ID struct {
+		Fork     string `bson:"fork"`
+		NextFork string `bson:"next_fork"`
+	} `bson:"_id"`
+	Count int `bson:"count"`
+}
+
+// AggregateByGoodbyeReason groups the peers that said goodbye, whether they are connectable or not
+func (s *mongoStore) AggregateByGoodbyeReason(ctx context.Context, network string) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "goodbye", Value: bson.D{{Key: "$ne", Value: nil}}},
+			}},
+		},
+
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$goodbye.reason"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// AggregateByFailure groups the peers whose last connection attempt failed by the failure category
+func (s *mongoStore) AggregateByFailure(ctx context.Context, network string) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "diagnostics", Value: bson.D{{Key: "$ne", Value: nil}}},
+				// failed attempts before a successful one are older than the connection
+				{Key: "$expr", Value: bson.D{{Key: "$gt", Value: bson.A{"$diagnostics.last_failure_time", "$last_connected"}}}},
+			}},
+		},
+
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$diagnostics.last_failure"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// AggregateByState counts the peers by lifecycle state, the peers stored before the state was tracked are new
+func (s *mongoStore) AggregateByState(ctx context.Context, network string) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "unknown_digest", Value: bson.D{{Key: "$ne", Value: true}}},
+			}},
+		},
+
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$state", models.PeerStateNew}}}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+type churnData struct {
+	Day    int64 `bson:"_id"`
+	Joins  int   `bson:"joins"`
+	Leaves int   `bson:"leaves"`
+}
+
+// transitionDocument is a lifecycle transition of a peer in the transition collection
+type transitionDocument struct {
+	PeerID  peer.ID `bson:"peer_id"`
+	Network string  `bson:"network"`
+	From    string  `bson:"from"`
+	To      string  `bson:"to"`
+	Time    int64   `bson:"time"`
+}
+
+// AddTransition stores the lifecycle transition of the peer. Transitions are only appended,
+// the peer itself keeps the last ones.
+func (s *mongoStore) AddTransition(ctx context.Context, peer *models.Peer, transition *models.StateTransition) error {
+	_, err := s.transitions.InsertOne(ctx, &transitionDocument{
+		PeerID:  peer.ID,
+		Network: peer.Network,
+		From:    transition.From,
+		To:      transition.To,
+		Time:    transition.Time,
+	})
+	return err
+}
+
+// AggregateChurn counts by day the peers joining and leaving between the given times.
+// Peers join when they become active without being active or flaky before, and leave when
+// they become unreachable after being active or flaky.
+func (s *mongoStore) AggregateChurn(ctx context.Context, network string, start, end int64) ([]*models.Churn, error) {
+	wasActive := bson.D{{Key: "$in", Value: bson.A{"$from", bson.A{models.PeerStateActive, models.PeerStateFlaky}}}}
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "time", Value: bson.D{{Key: "$gte", Value: start}, {Key: "$lte", Value: end}}},
+			}},
+		},
+		bson.D{
+			{Key: "$project", Value: bson.D{
+				{Key: "day", Value: bson.D{{Key: "$subtract", Value: bson.A{
+					"$time", bson.D{{Key: "$mod", Value: bson.A{"$time", 86400}}},
+				}}}},
+				{Key: "join", Value: bson.D{{Key: "$cond", Value: bson.A{
+					bson.D{{Key: "$and", Value: bson.A{
+						bson.D{{Key: "$eq", Value: bson.A{"$to", models.PeerStateActive}}},
+						bson.D{{Key: "$not", Value: bson.A{wasActive}}},
+					}}}, 1, 0,
+				}}}},
+				{Key: "leave", Value: bson.D{{Key: "$cond", Value: bson.A{
+					bson.D{{Key: "$and", Value: bson.A{
+						bson.D{{Key: "$eq", Value: bson.A{"$to", models.PeerStateUnreachable}}},
+						wasActive,
+					}}}, 1, 0,
+				}}}},
+			}},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$day"},
+				{Key: "joins", Value: bson.D{{Key: "$sum", Value: "$join"}}},
+				{Key: "leaves", Value: bson.D{{Key: "$sum", Value: "$leave"}}},
+			}},
+		},
+		bson.D{
+			{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}},
+		},
+	}
+	cursor, err := s.transitions.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.Churn
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(churnData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.Churn{Time: data.Day, Joins: data.Joins, Leaves: data.Leaves})
+	}
+	return result, nil
+}
+
+type uptimeDistribution struct {
+	ID     string  `bson:"_id"`
+	Peers  int     `bson:"peers"`
+	Mean   float64 `bson:"mean"`
+	Low    int     `bson:"low"`
+	Medium int     `bson:"medium"`
+	High   int     `bson:"high"`
+	Full   int     `bson:"full"`
+}
+
+// AggregateUptimeByClient groups the weekly uptime of the peers by client
+func (s *mongoStore) AggregateUptimeByClient(ctx context.Context, network string) ([]*models.UptimeDistribution, error) {
+	return s.aggregateUptime(ctx, uptimeFilter(network), "$user_agent.name")
+}
+
+// AggregateUptimeByProvider groups the weekly uptime of the hosted peers by provider
+func (s *mongoStore) AggregateUptimeByProvider(ctx context.Context, network string) ([]*models.UptimeDistribution, error) {
+	filter := append(uptimeFilter(network), bson.E{Key: "geo_location.asn.type", Value: models.UsageTypeHosting})
+	return s.aggregateUptime(ctx, filter, "$geo_location.asn.name")
+}
+
+// uptimeFilter matches the peers of a network having a weekly uptime, retired peers are left out
+func uptimeFilter(network string) bson.D {
+	return bson.D{
+		{Key: "network", Value: network},
+		{Key: "state", Value: bson.D{{Key: "$ne", Value: models.PeerStateRetired}}},
+		{Key: "uptime.week", Value: bson.D{{Key: "$ne", Value: nil}}},
+	}
+}
+
+func (s *mongoStore) aggregateUptime(ctx context.Context, filter bson.D, group string) ([]*models.UptimeDistribution, error) {
+	// between returns 1 when the weekly uptime is in [low, high)
+	between := func(low, high float64) bson.D {
+		return bson.D{{Key: "$cond", Value: bson.A{
+			bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "$gte", Value: bson.A{"$uptime.week", low}}},
+				bson.D{{Key: "$lt", Value: bson.A{"$uptime.week", high}}},
+			}}}, 1, 0,
+		}}}
+	}
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: filter},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: group},
+				{Key: "peers", Value: bson.D{{Key: "$sum", Value: 1}}},
+				{Key: "mean", Value: bson.D{{Key: "$avg", Value: "$uptime.week"}}},
+				{Key: "low", Value: bson.D{{Key: "$sum", Value: between(0, 50)}}},
+				{Key: "medium", Value: bson.D{{Key: "$sum", Value: between(50, 90)}}},
+				{Key: "high", Value: bson.D{{Key: "$sum", Value: between(90, 99)}}},
+				// uptimes are at most 100
+				{Key: "full", Value: bson.D{{Key: "$sum", Value: between(99, 101)}}},
+			}},
+		},
+		bson.D{
+			{Key: "$sort", Value: bson.D{{Key: "peers", Value: -1}}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.UptimeDistribution
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(uptimeDistribution)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.UptimeDistribution{
+			Name:   data.ID,
+			Peers:  data.Peers,
+			Mean:   data.Mean,
+			Low:    data.Low,
+			Medium: data.Medium,
+			High:   data.High,
+			Full:   data.Full,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByENRKey counts the peers announcing every key in their node record, whether they are connectable or not
+func (s *mongoStore) AggregateByENRKey(ctx context.Context, network string) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "unknown_digest", Value: bson.D{{Key: "$ne", Value: true}}},
+				{Key: "enr_entries", Value: bson.D{{Key: "$ne", Value: nil}}},
+			}},
+		},
+		bson.D{
+			{Key: "$unwind", Value: "$enr_entries"},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$enr_entries.key"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+// AggregateByIPVersion counts the IPv4 only, IPv6 only and dual-stack peers, whether they are connectable or not
+func (s *mongoStore) AggregateByIPVersion(ctx context.Context, network string) ([]*models.AggregateData, error) {
+	// ip holds the IPv6 address of IPv6 only peers
+	hasIPv4 := bson.D{{Key: "$gte", Value: bson.A{
+		bson.D{{Key: "$indexOfCP", Value: bson.A{"$ip", "."}}}, 0,
+	}}}
+	hasIPv6 := bson.D{{Key: "$gt", Value: bson.A{
+		bson.D{{Key: "$strLenCP", Value: bson.D{{Key: "$ifNull", Value: bson.A{"$ip6", ""}}}}}, 0,
+	}}}
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "unknown_digest", Value: bson.D{{Key: "$ne", Value: true}}},
+			}},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{{Key: "$switch", Value: bson.D{
+					{Key: "branches", Value: bson.A{
+						bson.D{
+							{Key: "case", Value: bson.D{{Key: "$and", Value: bson.A{hasIPv4, hasIPv6}}}},
+							{Key: "then", Value: models.IPVersionDualStack},
+						},
+						bson.D{
+							{Key: "case", Value: hasIPv4},
+							{Key: "then", Value: models.IPVersion4},
+						},
+					}},
+					{Key: "default", Value: models.IPVersion6},
+				}}}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
+type quicAdoption struct {
+	ID        string `bson:"_id"`
+	Count     int    `bson:"count"`
+	Announced int    `bson:"announced"`
+	Connected int    `bson:"connected"`
+}
+
+// AggregateQUICAdoption counts the peers of every client announcing QUIC and connected over QUIC
+func (s *mongoStore) AggregateQUICAdoption(ctx context.Context, network string) ([]*models.QUICAdoption, error) {
+	announced := bson.D{{Key: "$or", Value: bson.A{
+		bson.D{{Key: "$gt", Value: bson.A{"$quic_port", 0}}},
+		bson.D{{Key: "$gt", Value: bson.A{"$quic6_port", 0}}},
+	}}}
+	connected := bson.D{{Key: "$eq", Value: bson.A{"$transport", models.TransportQUIC}}}
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: connectableFilter(network)},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$user_agent.name"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+				{Key: "announced", Value: bson.D{{Key: "$sum", Value: bson.D{
+					{Key: "$cond", Value: bson.A{announced, 1, 0}},
+				}}}},
+				{Key: "connected", Value: bson.D{{Key: "$sum", Value: bson.D{
+					{Key: "$cond", Value: bson.A{connected, 1, 0}},
+				}}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.QUICAdoption
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(quicAdoption)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.QUICAdoption{
+			Client:    data.ID,
+			Count:     data.Count,
+			Announced: data.Announced,
+			Connected: data.Connected,
+		})
+	}
+	return result, nil
+}
+
+// AggregateByFork counts the connectable peers by fork, the peers on unknown fork digests are never
+// dialed and are counted from their records
+func (s *mongoStore) AggregateByFork(ctx context.Context, network string) ([]*models.ForkAggregation, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "$or", Value: bson.A{
+					bson.D{{Key: "is_connectable", Value: true}},
+					bson.D{{Key: "unknown_digest", Value: true}},
+				}},
+			}},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{
+					{Key: "fork", Value: "$fork_name"},
+					{Key: "next_fork", Value: "$next_fork_name"},
+				}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.ForkAggregation
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(forkAggregation)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.ForkAggregation{
+			Fork:     data.ID.Fork,
+			NextFork: data.ID.NextFork,
+			Count:    data.Count,
+		})
+	}
+	return result, nil
+}
+
+type clientForkAggregation struct {
+	ID struct {
+		Client   string `bson:"client"`
+		Version  string `bson:"version"`
+		Fork     string `bson:"fork"`
+		NextFork string `bson:"next_fork"`
+	} `bson:"_id"`
+	Count int `bson:"count"`
+}
+
+func (s *mongoStore) AggregateByClientFork(ctx context.Context, network string) ([]*models.ClientForkAggregation, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: connectableFilter(network)},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{
+					{Key: "client", Value: "$user_agent.name"},
+					{Key: "version", Value: "$user_agent.version"},
+					{Key: "fork", Value: "$fork_name"},
+					{Key: "next_fork", Value: "$next_fork_name"},
+				}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.ClientForkAggregation
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(clientForkAggregation)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.ClientForkAggregation{
+			Client:   data.ID.Client,
+			Version:  data.ID.Version,
+			Fork:     data.ID.Fork,
+			NextFork: data.ID.NextFork,
+			Count:    data.Count,
+		})
+	}
+	return result, nil
+}
+
+type checkpointAggregation struct {
+	ID struct {
+		Epoch  uint64 `bson:"epoch"`
+		Root   string `bson:"root"`
+		Client string `bson:"client"`
+	} `bson:"_id"`
+	Count int `bson:"count"`
+}
+
+func (s *mongoStore) AggregateByFinalizedCheckpoint(ctx context.Context, network string) ([]*models.CheckpointAggregation, error) {
+	filter := connectableFilter(network)
+	filter = append(filter, bson.E{Key: "chain_status", Value: bson.D{{Key: "$ne", Value: nil}}})
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: filter},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{
+					{Key: "epoch", Value: "$chain_status.finalized_epoch"},
+					{Key: "root", Value: "$chain_status.finalized_root"},
+					{Key: "client", Value: "$user_agent.name"},
+				}},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.CheckpointAggregation
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(checkpointAggregation)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.CheckpointAggregation{
+			Epoch:  data.ID.Epoch,
+			Root:   data.ID.Root,
+			Client: data.ID.Client,
+			Count:  data.Count,
+		})
+	}
+	return result, nil
+}
+
+// FlagMinorityFork flags the peers on the minority checkpoints and clears the flag of the others.
+// A single pipeline update sets both, so the flags are never seen half updated.
+func (s *mongoStore) FlagMinorityFork(ctx context.Context, network string, checkpoints []*models.FinalizedCheckpoint) error {
+	minority := bson.A{}
+	for _, cp := range checkpoints {
+		if cp.Minority {
+			minority = append(minority, bson.D{{Key: "$and", Value: bson.A{
+				bson.D{{Key: "$eq", Value: bson.A{"$chain_status.finalized_epoch", cp.Epoch}}},
+				bson.D{{Key: "$eq", Value: bson.A{"$chain_status.finalized_root", cp.Root}}},
+			}}})
+		}
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "minority_fork", Value: bson.D{{Key: "$or", Value: minority}}},
+		}}},
+	}
+	_, err := s.coll.UpdateMany(ctx, bson.D{{Key: "network", Value: network}}, update)
+	return err
+}
+
+// IncPropagation adds the delta to the propagation counters of the peer
+func (s *mongoStore) IncPropagation(ctx context.Context, peerID peer.ID, delta *models.Propagation) error {
+	filter := bson.D{
+		{Key: "_id", Value: peerID},
+	}
+	_, err := s.coll.UpdateOne(ctx, filter, bson.D{{Key: "$inc", Value: bson.D{
+		{Key: "propagation.blocks", Value: delta.Blocks},
+		{Key: "propagation.block_latency", Value: delta.BlockLatency},
+		{Key: "propagation.aggregates", Value: delta.Aggregates},
+		{Key: "propagation.aggregate_latency", Value: delta.AggregateLatency},
+	}}})
+	return err
+}
+
+type clientPropagation struct {
+	ID               string `bson:"_id"`
+	Peers            int    `bson:"peers"`
+	Blocks           int64  `bson:"blocks"`
+	BlockLatency     int64  `bson:"block_latency"`
+	Aggregates       int64  `bson:"aggregates"`
+	AggregateLatency int64  `bson:"aggregate_latency"`
+}
+
+// AggregatePropagationByClient sums the propagation counters of the peers of every client
+func (s *mongoStore) AggregatePropagationByClient(ctx context.Context, network string) ([]*models.ClientPropagation, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: bson.D{
+				{Key: "network", Value: network},
+				{Key: "propagation", Value: bson.D{{Key: "$ne", Value: nil}}},
+			}},
+		},
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$user_agent.name"},
+				{Key: "peers", Value: bson.D{{Key: "$sum", Value: 1}}},
+				{Key: "blocks", Value: bson.D{{Key: "$sum", Value: "$propagation.blocks"}}},
+				{Key: "block_latency", Value: bson.D{{Key: "$sum", Value: "$propagation.block_latency"}}},
+				{Key: "aggregates", Value: bson.D{{Key: "$sum", Value: "$propagation.aggregates"}}},
+				{Key: "aggregate_latency", Value: bson.D{{Key: "$sum", Value: "$propagation.aggregate_latency"}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.ClientPropagation
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(clientPropagation)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.ClientPropagation{
+			Client: data.ID,
+			Peers:  data.Peers,
+			Propagation: models.Propagation{
+				Blocks:           data.Blocks,
+				BlockLatency:     data.BlockLatency,
+				Aggregates:       data.Aggregates,
+				AggregateLatency: data.AggregateLatency,
+			},
+		})
+	}
+	return result, nil
+}
+
 type count struct {
 	Count int `json:"count" bson:"count"`
 }
@@ -354,7 +1179,7 @@ type aggregateSyncData struct {
 	Unsynced []count `json:"unsynced" bson:"unsynced"`
 }
 
-func (s *mongoStore) AggregateBySyncStatus(ctx context.Context) (*models.SyncAggregateData, error) {
+func (s *mongoStore) AggregateBySyncStatus(ctx context.Context, network string) (*models.SyncAggregateData, error) {
 	total := bson.A{bson.D{{Key: "$count", Value: "count"}}}
 	synced := bson.A{bson.D{{Key: "$match", Value: bson.D{{Key: "sync.status", Value: true}}}}, bson.D{{Key: "$count", Value: "count"}}}
 	unsynced := bson.A{bson.D{{Key: "$match", Value: bson.D{{Key: "sync.status", Value: false}}}}, bson.D{{Key: "$count", Value: "count"}}}
@@ -366,9 +1191,7 @@ func (s *mongoStore) AggregateBySyncStatus(ctx context.Context) (*models.SyncAgg
 
 	query := mongo.Pipeline{
 		bson.D{
-			{Key: "$match", Value: bson.D{
-				{Key: "is_connectable", Value: bson.D{{Key: "$eq", Value: true}}},
-			}},
+			{Key: "$match", Value: connectableFilter(network)},
 		},
 		facetStage,
 	}
@@ -397,6 +1220,38 @@ func (s *mongoStore) AggregateBySyncStatus(ctx context.Context) (*models.SyncAgg
 	return result, nil
 }
 
+func (s *mongoStore) AggregateBySyncState(ctx context.Context, network string) ([]*models.AggregateData, error) {
+	query := mongo.Pipeline{
+		bson.D{
+			{Key: "$match", Value: connectableFilter(network)},
+		},
+
+		bson.D{
+			{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: "$sync.state"},
+				{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			}},
+		},
+	}
+	cursor, err := s.coll.Aggregate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*models.AggregateData
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(aggregateData)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &models.AggregateData{Name: data.ID, Count: data.Count})
+	}
+	return result, nil
+}
+
 // New creates new instance of Entry Store based on MongoDB
 func New(cfg *config.Database) (peerstore.Provider, error) {
 	timeout := time.Duration(cfg.Timeout) * time.Second
@@ -423,9 +1278,25 @@ func New(cfg *config.Database) (peerstore.Provider, error) {
 		return nil, err
 	}
 
-	return &mongoStore{
-		client:  client,
-		coll:    client.Database(cfg.Database).Collection(cfg.Collection),
-		timeout: timeout,
-	}, nil
+	s := &mongoStore{
+		client:      client,
+		coll:        client.Database(cfg.Database).Collection(cfg.Collection),
+		transitions: client.Database(cfg.Database).Collection(cfg.TransitionCollection),
+		timeout:     timeout,
+	}
+	// ListForJob selects the peers of the network by next check time
+	_, err = s.coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "network", Value: 1}, {Key: "next_check_at", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the next check index: %w", err)
+	}
+	// AggregateChurn selects the transitions of the network by time
+	_, err = s.transitions.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "network", Value: 1}, {Key: "time", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the transition index: %w", err)
+	}
+	return s, nil
 }
@@ -6,27 +6,46 @@ package peerstore
 
 import (
 	"context"
-	"time"
 
 	"eth2-crawler/models"
 
-	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 // Provider represents store provider interface that can be implemented by different DB engines
 type Provider interface {
 	Create(ctx context.Context, peer *models.Peer) error
 	Update(ctx context.Context, peer *models.Peer) error
+	UpdateENR(ctx context.Context, peer *models.Peer) (*models.Peer, error)
+	Recheck(ctx context.Context, peerID peer.ID) error
 	Upsert(ctx context.Context, peer *models.Peer) error
 	View(ctx context.Context, peerID peer.ID) (*models.Peer, error)
 	Delete(ctx context.Context, peer *models.Peer) error
+	BackfillNetwork(ctx context.Context, network string) error
 	// Todo: accept filter and find options to get limited information
-	ViewAll(ctx context.Context) ([]*models.Peer, error)
-	ListForJob(ctx context.Context, lastUpdated time.Duration, limit int) ([]*models.Peer, error)
-	AggregateByAgentName(ctx context.Context) ([]*models.AggregateData, error)
-	AggregateByOperatingSystem(ctx context.Context) ([]*models.AggregateData, error)
-	AggregateByCountry(ctx context.Context) ([]*models.AggregateData, error)
-	AggregateByNetworkType(ctx context.Context) ([]*models.AggregateData, error)
-	AggregateBySyncStatus(ctx context.Context) (*models.SyncAggregateData, error)
-	AggregateByClientVersion(ctx context.Context) ([]*models.ClientVersionAggregation, error)
+	ViewAll(ctx context.Context, network string) ([]*models.Peer, error)
+	ListForJob(ctx context.Context, network string, limit int) ([]*models.Peer, error)
+	AggregateByAgentName(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateByOperatingSystem(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateByCountry(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateByNetworkType(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateBySyncStatus(ctx context.Context, network string) (*models.SyncAggregateData, error)
+	AggregateBySyncState(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateByClientVersion(ctx context.Context, network string) ([]*models.ClientVersionAggregation, error)
+	AggregateByFork(ctx context.Context, network string) ([]*models.ForkAggregation, error)
+	AggregateByClientFork(ctx context.Context, network string) ([]*models.ClientForkAggregation, error)
+	AggregateByGoodbyeReason(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateByFailure(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateByState(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AddTransition(ctx context.Context, peer *models.Peer, transition *models.StateTransition) error
+	AggregateChurn(ctx context.Context, network string, start, end int64) ([]*models.Churn, error)
+	AggregateUptimeByClient(ctx context.Context, network string) ([]*models.UptimeDistribution, error)
+	AggregateUptimeByProvider(ctx context.Context, network string) ([]*models.UptimeDistribution, error)
+	AggregateByENRKey(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateByIPVersion(ctx context.Context, network string) ([]*models.AggregateData, error)
+	AggregateQUICAdoption(ctx context.Context, network string) ([]*models.QUICAdoption, error)
+	AggregateByFinalizedCheckpoint(ctx context.Context, network string) ([]*models.CheckpointAggregation, error)
+	FlagMinorityFork(ctx context.Context, network string, checkpoints []*models.FinalizedCheckpoint) error
+	IncPropagation(ctx context.Context, peerID peer.ID, delta *models.Propagation) error
+	AggregatePropagationByClient(ctx context.Context, network string) ([]*models.ClientPropagation, error)
 }
@@ -6,6 +6,7 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"eth2-crawler/models"
 	"eth2-crawler/store/record"
 	"eth2-crawler/utils/config"
@@ -20,9 +21,11 @@ import (
 )
 
 type mongoStore struct {
-	client  *mongo.Client
-	coll    *mongo.Collection
-	timeout time.Duration
+	client    *mongo.Client
+	coll      *mongo.Collection
+	snapshots *mongo.Collection
+	rounds    *mongo.Collection
+	timeout   time.Duration
 }
 
 // New creates new instance of History Store based on MongoDB
@@ -51,20 +54,105 @@ func New(cfg *config.Database) (record.Provider, error) {
 		return nil, err
 	}
 
-	return &mongoStore{
-		client:  client,
-		coll:    client.Database(cfg.Database).Collection(cfg.HistoryCollection),
-		timeout: timeout,
-	}, nil
+	s := &mongoStore{
+		client:    client,
+		coll:      client.Database(cfg.Database).Collection(cfg.HistoryCollection),
+		snapshots: client.Database(cfg.Database).Collection(cfg.SnapshotCollection),
+		rounds:    client.Database(cfg.Database).Collection(cfg.CrawlRoundCollection),
+		timeout:   timeout,
+	}
+	// a single record per bucket, the records taken before the buckets have none
+	bucketIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "network", Value: 1}, {Key: "bucket", Value: 1}},
+		Options: options.Index().SetUnique(true).
+			SetPartialFilterExpression(bson.D{{Key: "bucket", Value: bson.D{{Key: "$exists", Value: true}}}}),
+	}
+	for _, coll := range []*mongo.Collection{s.coll, s.snapshots} {
+		_, err = coll.Indexes().CreateOne(ctx, bucketIndex)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create the bucket index: %w", err)
+		}
+	}
+	_, err = s.snapshots.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "network", Value: 1}, {Key: "time", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the snapshot time index: %w", err)
+	}
+	_, err = s.rounds.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "network", Value: 1}, {Key: "end", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the crawl round index: %w", err)
+	}
+	return s, nil
+}
+
+// Upsert stores the history record of its bucket, replacing the one taken before for the same bucket
+func (s mongoStore) Upsert(ctx context.Context, history *models.History) error {
+	return upsertBucket(ctx, s.coll, history.Network, history.Bucket, history)
+}
+
+// HasBucket reports whether the history record of the bucket is stored
+func (s mongoStore) HasBucket(ctx context.Context, network string, bucket int64) (bool, error) {
+	filter := bson.D{
+		{Key: "network", Value: network},
+		{Key: "bucket", Value: bucket},
+	}
+	count, err := s.coll.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// BackfillNetwork tags the history records taken before the network profiles with the network
+func (s mongoStore) BackfillNetwork(ctx context.Context, network string) error {
+	filter := bson.D{{Key: "network", Value: bson.D{{Key: "$exists", Value: false}}}}
+	_, err := s.coll.UpdateMany(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "network", Value: network}}}})
+	return err
 }
 
-func (s mongoStore) Create(ctx context.Context, history *models.History) error {
-	_, err := s.coll.InsertOne(ctx, history, options.InsertOne())
+// upsertBucket stores the document as the one of its bucket. The id of a replaced document is kept,
+// and a concurrent insert of the same bucket by another replica wins.
+func upsertBucket(ctx context.Context, coll *mongo.Collection, network string, bucket int64, document interface{}) error {
+	data, err := bson.Marshal(document)
+	if err != nil {
+		return err
+	}
+	var doc bson.D
+	err = bson.Unmarshal(data, &doc)
+	if err != nil {
+		return err
+	}
+	set := bson.D{}
+	setOnInsert := bson.D{}
+	for _, e := range doc {
+		if e.Key == "_id" {
+			setOnInsert = append(setOnInsert, e)
+			continue
+		}
+		set = append(set, e)
+	}
+	filter := bson.D{
+		{Key: "network", Value: network},
+		{Key: "bucket", Value: bucket},
+	}
+	update := bson.D{
+		{Key: "$set", Value: set},
+		{Key: "$setOnInsert", Value: setOnInsert},
+	}
+	_, err = coll.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
 	return err
 }
 
-func (s mongoStore) GetHistory(ctx context.Context, start int64, end int64) ([]*models.HistoryCount, error) {
+// GetHistory returns the history records of the network between start and end
+func (s mongoStore) GetHistory(ctx context.Context, network string, start int64, end int64) ([]*models.HistoryCount, error) {
 	filter := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "network", Value: network}},
 		bson.D{{Key: "time", Value: bson.D{{Key: "$gt", Value: start}}}},
 		bson.D{{Key: "time", Value: bson.D{{Key: "$lt", Value: end}}}},
 	}}}
@@ -89,7 +177,120 @@ func (s mongoStore) GetHistory(ctx context.Context, start int64, end int64) ([]*
 			Time:        v.Time,
 			TotalNodes:  v.Eth2Nodes,
 			SyncedNodes: v.SyncNodes,
+			Estimate:    v.Estimate,
 		})
 	}
 	return count, nil
 }
+
+// InsertCrawlRound stores the snapshot of a completed crawl round
+func (s mongoStore) InsertCrawlRound(ctx context.Context, round *models.CrawlRound) error {
+	_, err := s.rounds.InsertOne(ctx, round)
+	return err
+}
+
+// GetCrawlRounds returns the crawl rounds of the network completed between start and end, the oldest first
+func (s mongoStore) GetCrawlRounds(ctx context.Context, network string, start, end int64) ([]*models.CrawlRound, error) {
+	filter := bson.D{
+		{Key: "network", Value: network},
+		{Key: "end", Value: bson.D{{Key: "$gt", Value: start}, {Key: "$lt", Value: end}}},
+	}
+	cursor, err := s.rounds.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "end", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.CrawlRound, 0)
+	for cursor.Next(ctx) {
+		data := new(models.CrawlRound)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
+
+// LatestCrawlRound returns the number of the last crawl round of the network, zero when there is none
+func (s mongoStore) LatestCrawlRound(ctx context.Context, network string) (int, error) {
+	filter := bson.D{{Key: "network", Value: network}}
+	data := new(models.CrawlRound)
+	err := s.rounds.FindOne(ctx, filter, options.FindOne().SetSort(bson.D{{Key: "end", Value: -1}})).Decode(data)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return data.Round, nil
+}
+
+// LatestEstimate returns the network size estimate of the latest history record having one
+func (s mongoStore) LatestEstimate(ctx context.Context, network string) (*models.NetworkEstimate, error) {
+	filter := bson.D{
+		{Key: "network", Value: network},
+		{Key: "estimate", Value: bson.D{{Key: "$ne", Value: nil}}},
+	}
+	data := new(models.History)
+	err := s.coll.FindOne(ctx, filter, options.FindOne().SetSort(bson.D{{Key: "time", Value: -1}})).Decode(data)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return data.Estimate, nil
+}
+
+// UpsertSnapshot stores the snapshot of its bucket, replacing the one taken before for the same bucket
+func (s mongoStore) UpsertSnapshot(ctx context.Context, snapshot *models.Snapshot) error {
+	return upsertBucket(ctx, s.snapshots, snapshot.Network, snapshot.Bucket, snapshot)
+}
+
+// GetSnapshots returns the given aggregations of the snapshots of the network between start and end,
+// the oldest first. With a positive interval, only the first snapshot of every interval is returned.
+func (s mongoStore) GetSnapshots(ctx context.Context, network string, start, end, interval int64, fields ...string) ([]*models.Snapshot, error) {
+	projection := bson.D{
+		{Key: "time", Value: 1},
+		{Key: "network", Value: 1},
+		{Key: "bucket", Value: 1},
+	}
+	for _, field := range fields {
+		projection = append(projection, bson.E{Key: field, Value: 1})
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "network", Value: network},
+			{Key: "time", Value: bson.D{{Key: "$gt", Value: start}, {Key: "$lt", Value: end}}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "time", Value: 1}}}},
+		{{Key: "$project", Value: projection}},
+	}
+	if interval > 0 {
+		pipeline = append(pipeline,
+			bson.D{{Key: "$group", Value: bson.D{
+				{Key: "_id", Value: bson.D{{Key: "$subtract", Value: bson.A{
+					"$time", bson.D{{Key: "$mod", Value: bson.A{"$time", interval}}},
+				}}}},
+				{Key: "snapshot", Value: bson.D{{Key: "$first", Value: "$$ROOT"}}},
+			}}},
+			bson.D{{Key: "$replaceRoot", Value: bson.D{{Key: "newRoot", Value: "$snapshot"}}}},
+			bson.D{{Key: "$sort", Value: bson.D{{Key: "time", Value: 1}}}},
+		)
+	}
+	cursor, err := s.snapshots.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.Snapshot, 0)
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(models.Snapshot)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
@@ -11,6 +11,14 @@ import (
 
 // Provider represents store provider interface that can be implemented by different DB engines
 type Provider interface {
-	Create(ctx context.Context, history *models.History) error
-	GetHistory(ctx context.Context, start int64, end int64) ([]*models.HistoryCount, error)
+	Upsert(ctx context.Context, history *models.History) error
+	HasBucket(ctx context.Context, network string, bucket int64) (bool, error)
+	BackfillNetwork(ctx context.Context, network string) error
+	GetHistory(ctx context.Context, network string, start int64, end int64) ([]*models.HistoryCount, error)
+	LatestEstimate(ctx context.Context, network string) (*models.NetworkEstimate, error)
+	UpsertSnapshot(ctx context.Context, snapshot *models.Snapshot) error
+	GetSnapshots(ctx context.Context, network string, start, end, interval int64, fields ...string) ([]*models.Snapshot, error)
+	InsertCrawlRound(ctx context.Context, round *models.CrawlRound) error
+	GetCrawlRounds(ctx context.Context, network string, start, end int64) ([]*models.CrawlRound, error)
+	LatestCrawlRound(ctx context.Context, network string) (int, error)
 }
@@ -0,0 +1,137 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package mongo implements all the store methods
+package mongo
+
+import (
+	"context"
+	"errors"
+	"eth2-crawler/models"
+	"eth2-crawler/store/uptimestore"
+	"eth2-crawler/utils/config"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+// errNamespaceExists is returned when creating a collection that exists
+const errNamespaceExists = 48
+
+type mongoStore struct {
+	client  *mongo.Client
+	coll    *mongo.Collection
+	timeout time.Duration
+}
+
+// New creates new instance of Uptime Store based on MongoDB.
+// The checks are kept in a time series collection, which requires MongoDB 5.0.
+func New(cfg *config.Database) (uptimestore.Provider, error) {
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	opts := options.Client()
+
+	opts.ApplyURI(cfg.URI)
+	client, err := mongo.NewClient(opts)
+	if err != nil {
+		return nil, fmt.Errorf("connecton error [%s]: %w", opts.GetURI(), err)
+	}
+
+	// connect to the mongoDB cluster
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err = client.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// test the connection
+	err = client.Ping(ctx, readpref.Primary())
+	if err != nil {
+		return nil, err
+	}
+
+	// the checks are kept for the longest uptime window
+	db := client.Database(cfg.Database)
+	expireAfter := int64(models.UptimeMonth.Seconds())
+	tsOpts := options.TimeSeries().SetTimeField("time").SetMetaField("peer_id")
+	err = db.CreateCollection(ctx, cfg.UptimeCollection, options.CreateCollection().
+		SetTimeSeriesOptions(tsOpts).SetExpireAfterSeconds(expireAfter))
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == errNamespaceExists {
+		// the collection was created on an earlier start, or by someone else
+		err = checkTimeSeries(ctx, db, cfg.UptimeCollection)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the time series collection: %w", err)
+	}
+
+	coll := db.Collection(cfg.UptimeCollection)
+	_, err = coll.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "peer_id", Value: 1}, {Key: "time", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the peer index: %w", err)
+	}
+
+	return &mongoStore{
+		client:  client,
+		coll:    coll,
+		timeout: timeout,
+	}, nil
+}
+
+// checkTimeSeries returns an error unless the collection of the given name is a time series collection
+func checkTimeSeries(ctx context.Context, db *mongo.Database, name string) error {
+	cursor, err := db.ListCollections(ctx, bson.D{{Key: "name", Value: name}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	var spec struct {
+		Type string `bson:"type"`
+	}
+	if cursor.Next(ctx) {
+		err = cursor.Decode(&spec)
+		if err != nil {
+			return err
+		}
+	}
+	if spec.Type != "timeseries" {
+		return fmt.Errorf("collection %q exists and is not a time series collection, it must be one created on MongoDB 5.0 or later", name)
+	}
+	return nil
+}
+
+func (s mongoStore) Create(ctx context.Context, check *models.Check) error {
+	_, err := s.coll.InsertOne(ctx, check, options.InsertOne())
+	return err
+}
+
+// List returns the checks of the peer since the given time, the oldest first
+func (s mongoStore) List(ctx context.Context, peerID peer.ID, since int64) ([]*models.Check, error) {
+	filter := bson.D{
+		{Key: "peer_id", Value: peerID},
+		{Key: "time", Value: bson.D{{Key: "$gte", Value: time.Unix(since, 0)}}},
+	}
+	cursor, err := s.coll.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "time", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*models.Check, 0)
+	for cursor.Next(ctx) {
+		// create a value into which the single document can be decoded
+		data := new(models.Check)
+		err := cursor.Decode(data)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, data)
+	}
+	return result, nil
+}
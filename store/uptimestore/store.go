@@ -0,0 +1,19 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+// Package uptimestore implements db for the outcome of the peer updates
+package uptimestore
+
+import (
+	"context"
+
+	"eth2-crawler/models"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+)
+
+// Provider represents store provider interface that can be implemented by different DB engines
+type Provider interface {
+	Create(ctx context.Context, check *models.Check) error
+	List(ctx context.Context, peerID peer.ID, since int64) ([]*models.Check, error)
+}
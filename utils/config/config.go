@@ -8,16 +8,53 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/go-version"
+	"github.com/robfig/cron/v3"
 	"gopkg.in/yaml.v2"
 )
 
+// DefaultNetwork is crawled when no network is configured
+const DefaultNetwork = "mainnet"
+
+// DefaultENRCollection keeps the previous node records when no collection is configured
+const DefaultENRCollection = "enr_history"
+
+// DefaultNodeCollection keeps the nodes seen in discovery when no collection is configured
+const DefaultNodeCollection = "discovered_nodes"
+
+// DefaultUptimeCollection keeps the outcome of the peer updates when no collection is configured
+const DefaultUptimeCollection = "uptime"
+
+// DefaultSnapshotCollection keeps the aggregation snapshots when no collection is configured
+const DefaultSnapshotCollection = "snapshots"
+
+// DefaultCrawlRoundCollection keeps the completed crawl rounds when no collection is configured
+const DefaultCrawlRoundCollection = "crawl_rounds"
+
+// DefaultTransitionCollection keeps the lifecycle transitions of the peers when no collection is configured
+const DefaultTransitionCollection = "transitions"
+
+// Crawl modes select how the discovery keyspace is explored
+const (
+	// CrawlModeRandom follows random walks of the discovery table
+	CrawlModeRandom = "random"
+	// CrawlModeSweep asks every discovered node for its buckets with FINDNODE requests, in rounds
+	CrawlModeSweep = "sweep"
+)
+
 // Configuration holds data necessary for configuring application
 type Configuration struct {
-	Server   *Server   `yaml:"server,omitempty"`
-	Database *Database `yaml:"database,omitempty"`
-	Resolver *Resolver `yaml:"resolver,omitempty"`
+	Server        *Server       `yaml:"server,omitempty"`
+	Database      *Database     `yaml:"database,omitempty"`
+	Resolver      *Resolver     `yaml:"resolver,omitempty"`
+	Network       *Network      `yaml:"network,omitempty"`
+	Crawler       *Crawler      `yaml:"crawler,omitempty"`
+	ForkReadiness ForkReadiness `yaml:"fork_readiness,omitempty"`
 }
 
 // Server holds data necessary for server configuration
@@ -26,6 +63,8 @@ type Server struct {
 	ReadTimeout  int      `yaml:"read_timeout_seconds,omitempty"`
 	WriteTimeout int      `yaml:"write_timeout_seconds,omitempty"`
 	CORS         []string `yaml:"cors,omitempty"`
+	// AdminToken enables the admin endpoints, it is read from ADMIN_TOKEN
+	AdminToken string `yaml:"-"`
 }
 
 // Database is a MongoDB config
@@ -35,6 +74,18 @@ type Database struct {
 	Database          string `yaml:"database"`
 	Collection        string `yaml:"collection"`
 	HistoryCollection string `yaml:"history_collection"`
+	// ENRCollection keeps the previous node records of peers
+	ENRCollection string `yaml:"enr_collection"`
+	// NodeCollection keeps the nodes seen in discovery, whether they can be dialed or not
+	NodeCollection string `yaml:"node_collection"`
+	// UptimeCollection is the time series of the outcome of the peer updates
+	UptimeCollection string `yaml:"uptime_collection"`
+	// SnapshotCollection keeps the results of all the aggregations over time
+	SnapshotCollection string `yaml:"snapshot_collection"`
+	// CrawlRoundCollection keeps the completed rounds of the sweep crawl mode
+	CrawlRoundCollection string `yaml:"crawl_round_collection"`
+	// TransitionCollection keeps every lifecycle transition of the peers
+	TransitionCollection string `yaml:"transition_collection"`
 }
 
 // Resolver provides config for resolver
@@ -43,6 +94,315 @@ type Resolver struct {
 	Timeout int    `yaml:"request_timeout_sec"`
 }
 
+// Network holds the eth2 network profile to crawl.
+// Name selects a built-in profile (mainnet, prater, sepolia), any other field overrides it.
+// Custom devnets must provide all the fields.
+type Network struct {
+	Name                  string  `yaml:"name"`
+	GenesisTime           int64   `yaml:"genesis_time,omitempty"`
+	GenesisValidatorsRoot string  `yaml:"genesis_validators_root,omitempty"`
+	SecondsPerSlot        int     `yaml:"seconds_per_slot,omitempty"`
+	Forks                 []*Fork `yaml:"forks,omitempty"`
+	// BlobSchedule lists the blob parameter changes, from Fulu on they change the fork digest
+	BlobSchedule []*BlobParameters `yaml:"blob_schedule,omitempty"`
+	Bootnodes    []string          `yaml:"bootnodes,omitempty"`
+	Head         *Head             `yaml:"head,omitempty"`
+}
+
+// BlobParameters is an entry of the blob schedule
+type BlobParameters struct {
+	Epoch            uint64 `yaml:"epoch"`
+	MaxBlobsPerBlock uint64 `yaml:"max_blobs_per_block"`
+}
+
+// Head is the checkpoint the crawler announces when answering status requests until the
+// head of the majority of the crawled peers is known. The head slot follows the wall clock.
+type Head struct {
+	FinalizedRoot  string `yaml:"finalized_root"`
+	FinalizedEpoch uint64 `yaml:"finalized_epoch"`
+	HeadRoot       string `yaml:"head_root"`
+	HeadSlot       uint64 `yaml:"head_slot"`
+}
+
+// Fork holds a single entry of the network fork schedule
+type Fork struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+	Epoch   uint64 `yaml:"epoch"`
+}
+
+// Crawler holds the settings of the crawler.
+// The runtime settings can be overridden by the CRAWLER_* environment variables.
+type Crawler struct {
+	Mode string `yaml:"mode,omitempty"`
+	// SweepMinDistance is the log distance of the closest bucket asked for in sweep mode
+	SweepMinDistance int `yaml:"sweep_min_distance,omitempty"`
+	// SweepEmptyBuckets ends the walk of a node after this many empty buckets in a row,
+	// every bucket down to SweepMinDistance is asked for when zero
+	SweepEmptyBuckets int    `yaml:"sweep_empty_buckets,omitempty"`
+	ListenAddress     string `yaml:"listen_address,omitempty"`
+	// ListenAddress6 enables the IPv6 listener, libp2p and discovery listen on both addresses
+	ListenAddress6 string `yaml:"listen_address6,omitempty"`
+	TCPPort        int    `yaml:"tcp_port,omitempty"`
+	UDPPort        int    `yaml:"udp_port,omitempty"`
+	UserAgent      string `yaml:"user_agent,omitempty"`
+	// Concurrency is the number of peers updated at the same time
+	Concurrency int `yaml:"concurrency,omitempty"`
+	// RecheckInterval is the time between two updates of a reachable peer
+	RecheckInterval time.Duration `yaml:"recheck_interval,omitempty"`
+	// Retries is the number of connection attempts of every peer update
+	Retries int `yaml:"retries,omitempty"`
+	// RetryInterval is the time between two connection attempts of an update
+	RetryInterval time.Duration `yaml:"retry_interval,omitempty"`
+	// BackoffBase is the time before updating a peer again after a failed update,
+	// it doubles with every consecutive failure up to MaxBackoff
+	BackoffBase time.Duration `yaml:"backoff_base,omitempty"`
+	MaxBackoff  time.Duration `yaml:"max_backoff,omitempty"`
+	// Retention is the time unreachable peers are updated before they retire
+	Retention time.Duration `yaml:"retention,omitempty"`
+	// FinalityInterval is the time between two checks of the finalized checkpoints of peers
+	FinalityInterval time.Duration `yaml:"finality_interval,omitempty"`
+	// HistorySchedule is the cron expression of the history records and the aggregation snapshots,
+	// every activation starts the bucket the records are stored under
+	HistorySchedule string `yaml:"history_schedule,omitempty"`
+
+	// ProbeBlocks requests blocks from peers to verify their head and estimate their history
+	ProbeBlocks bool  `yaml:"probe_blocks,omitempty"`
+	Sync        *Sync `yaml:"sync,omitempty"`
+	// Gossip subscribes to the block and aggregate topics to measure their propagation
+	Gossip bool `yaml:"gossip,omitempty"`
+	// KeyFile holds the node key, it is generated on first start. The key is ephemeral when empty.
+	KeyFile string `yaml:"key_file,omitempty"`
+	// NodeDB is the directory of the discovery node database. It is kept in memory when empty.
+	NodeDB string `yaml:"node_db,omitempty"`
+}
+
+// Sync holds the thresholds between the synced, behind and stalled states of peers
+type Sync struct {
+	// SyncedSlots is the maximum distance in slots of synced peers
+	SyncedSlots int64 `yaml:"synced_slots"`
+	// BehindSlots is the maximum distance in slots of peers catching up, farther ones are stalled
+	BehindSlots int64 `yaml:"behind_slots"`
+	// FinalizedLagEpochs is the maximum number of epochs the finalized checkpoint of synced peers
+	// can lag behind the one of the majority
+	FinalizedLagEpochs int64 `yaml:"finalized_lag_epochs"`
+}
+
+// DefaultSync is used when no sync thresholds are configured
+var DefaultSync = Sync{
+	SyncedSlots:        64,
+	BehindSlots:        8192,
+	FinalizedLagEpochs: 2,
+}
+
+// DefaultCrawler holds the runtime settings used when they are not configured
+var DefaultCrawler = Crawler{
+	Mode:             CrawlModeRandom,
+	SweepMinDistance: 240,
+	ListenAddress:    "0.0.0.0",
+	TCPPort:          30304,
+	UDPPort:          30304,
+	UserAgent:        "Eth2-Crawler",
+	Concurrency:      200,
+	RecheckInterval:  24 * time.Hour,
+	Retries:          3,
+	RetryInterval:    5 * time.Second,
+	BackoffBase:      10 * time.Minute,
+	MaxBackoff:       7 * 24 * time.Hour,
+	Retention:        30 * 24 * time.Hour,
+	FinalityInterval: 10 * time.Minute,
+	HistorySchedule:  "@daily",
+}
+
+// ForkReadiness maps fork names to the minimum version of each client supporting it
+type ForkReadiness map[string]map[string]string
+
+func (f ForkReadiness) validate() error {
+	for fork, clients := range f {
+		for client, ver := range clients {
+			if _, err := ParseVersion(ver); err != nil {
+				return fmt.Errorf("fork_readiness version %q of client %s for fork %s: %w", ver, client, fork, err)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseVersion parses a client version, with or without the v prefix
+func ParseVersion(ver string) (*version.Version, error) {
+	if len(ver) != 0 && ver[0:1] != "v" {
+		ver = "v" + ver
+	}
+	return version.NewVersion(ver)
+}
+
+func (c *Crawler) setDefaults() {
+	if c.Mode == "" {
+		c.Mode = DefaultCrawler.Mode
+	}
+	if c.SweepMinDistance == 0 {
+		c.SweepMinDistance = DefaultCrawler.SweepMinDistance
+	}
+	if c.ListenAddress == "" {
+		c.ListenAddress = DefaultCrawler.ListenAddress
+	}
+	if c.TCPPort == 0 {
+		c.TCPPort = DefaultCrawler.TCPPort
+	}
+	if c.UDPPort == 0 {
+		c.UDPPort = DefaultCrawler.UDPPort
+	}
+	if c.UserAgent == "" {
+		c.UserAgent = DefaultCrawler.UserAgent
+	}
+	if c.Concurrency == 0 {
+		c.Concurrency = DefaultCrawler.Concurrency
+	}
+	if c.RecheckInterval == 0 {
+		c.RecheckInterval = DefaultCrawler.RecheckInterval
+	}
+	if c.Retries == 0 {
+		c.Retries = DefaultCrawler.Retries
+	}
+	if c.RetryInterval == 0 {
+		c.RetryInterval = DefaultCrawler.RetryInterval
+	}
+	if c.BackoffBase == 0 {
+		c.BackoffBase = DefaultCrawler.BackoffBase
+	}
+	if c.MaxBackoff == 0 {
+		c.MaxBackoff = DefaultCrawler.MaxBackoff
+	}
+	if c.Retention == 0 {
+		c.Retention = DefaultCrawler.Retention
+	}
+	if c.FinalityInterval == 0 {
+		c.FinalityInterval = DefaultCrawler.FinalityInterval
+	}
+	if c.HistorySchedule == "" {
+		c.HistorySchedule = DefaultCrawler.HistorySchedule
+	}
+}
+
+// loadEnv overrides the runtime settings with the environment variables that are set
+func (c *Crawler) loadEnv() error {
+	if v, ok := os.LookupEnv("CRAWLER_MODE"); ok {
+		c.Mode = v
+	}
+	if v, ok := os.LookupEnv("CRAWLER_LISTEN_ADDRESS"); ok {
+		c.ListenAddress = v
+	}
+	if v, ok := os.LookupEnv("CRAWLER_LISTEN_ADDRESS6"); ok {
+		c.ListenAddress6 = v
+	}
+	if v, ok := os.LookupEnv("CRAWLER_USER_AGENT"); ok {
+		c.UserAgent = v
+	}
+	if v, ok := os.LookupEnv("CRAWLER_HISTORY_SCHEDULE"); ok {
+		c.HistorySchedule = v
+	}
+	ints := map[string]*int{
+		"CRAWLER_SWEEP_MIN_DISTANCE":  &c.SweepMinDistance,
+		"CRAWLER_SWEEP_EMPTY_BUCKETS": &c.SweepEmptyBuckets,
+		"CRAWLER_TCP_PORT":            &c.TCPPort,
+		"CRAWLER_UDP_PORT":            &c.UDPPort,
+		"CRAWLER_CONCURRENCY":         &c.Concurrency,
+		"CRAWLER_RETRIES":             &c.Retries,
+	}
+	for name, field := range ints {
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s, %w", name, err)
+		}
+		*field = n
+	}
+	durations := map[string]*time.Duration{
+		"CRAWLER_RECHECK_INTERVAL":  &c.RecheckInterval,
+		"CRAWLER_RETRY_INTERVAL":    &c.RetryInterval,
+		"CRAWLER_BACKOFF_BASE":      &c.BackoffBase,
+		"CRAWLER_MAX_BACKOFF":       &c.MaxBackoff,
+		"CRAWLER_RETENTION":         &c.Retention,
+		"CRAWLER_FINALITY_INTERVAL": &c.FinalityInterval,
+	}
+	for name, field := range durations {
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid %s, %w", name, err)
+		}
+		*field = d
+	}
+	return nil
+}
+
+func (c *Crawler) validate() error {
+	if c.Mode != CrawlModeRandom && c.Mode != CrawlModeSweep {
+		return fmt.Errorf("crawler mode %q must be %s or %s", c.Mode, CrawlModeRandom, CrawlModeSweep)
+	}
+	if c.SweepMinDistance < 1 || c.SweepMinDistance > 256 {
+		return fmt.Errorf("crawler sweep_min_distance %d is out of range", c.SweepMinDistance)
+	}
+	if c.SweepEmptyBuckets < 0 {
+		return errors.New("crawler sweep_empty_buckets must not be negative")
+	}
+	if net.ParseIP(c.ListenAddress) == nil {
+		return fmt.Errorf("crawler listen_address %q is not an ip address", c.ListenAddress)
+	}
+	if c.ListenAddress6 != "" {
+		ip := net.ParseIP(c.ListenAddress6)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("crawler listen_address6 %q is not an ipv6 address", c.ListenAddress6)
+		}
+	}
+	if c.TCPPort <= 0 || c.TCPPort > 65535 {
+		return fmt.Errorf("crawler tcp_port %d is out of range", c.TCPPort)
+	}
+	if c.UDPPort <= 0 || c.UDPPort > 65535 {
+		return fmt.Errorf("crawler udp_port %d is out of range", c.UDPPort)
+	}
+	if c.UserAgent == "" {
+		return errors.New("crawler user_agent must not be empty")
+	}
+	if c.Concurrency <= 0 {
+		return errors.New("crawler concurrency must be positive")
+	}
+	if c.RecheckInterval <= 0 {
+		return errors.New("crawler recheck_interval must be positive")
+	}
+	if c.Retries <= 0 {
+		return errors.New("crawler retries must be positive")
+	}
+	if c.RetryInterval <= 0 {
+		return errors.New("crawler retry_interval must be positive")
+	}
+	if c.BackoffBase <= 0 {
+		return errors.New("crawler backoff_base must be positive")
+	}
+	if c.MaxBackoff < c.BackoffBase {
+		return errors.New("crawler max_backoff must not be shorter than backoff_base")
+	}
+	if c.Retention <= 0 {
+		return errors.New("crawler retention must be positive")
+	}
+	if c.FinalityInterval <= 0 {
+		return errors.New("crawler finality_interval must be positive")
+	}
+	if _, err := cron.ParseStandard(c.HistorySchedule); err != nil {
+		return fmt.Errorf("crawler history_schedule %q is invalid, %w", c.HistorySchedule, err)
+	}
+	if c.Sync.SyncedSlots > c.Sync.BehindSlots {
+		return errors.New("crawler sync synced_slots must not exceed behind_slots")
+	}
+	return nil
+}
+
 func loadDatabaseURI() (string, error) {
 	mongoURI := os.Getenv("MONGODB_URI")
 	if mongoURI == "" {
@@ -73,6 +433,45 @@ func Load(path string) (*Configuration, error) {
 		return nil, fmt.Errorf("unable to decode into struct, %w", err)
 	}
 
+	if cfg.Network == nil {
+		cfg.Network = &Network{Name: DefaultNetwork}
+	}
+	if cfg.Database != nil && cfg.Database.ENRCollection == "" {
+		cfg.Database.ENRCollection = DefaultENRCollection
+	}
+	if cfg.Database != nil && cfg.Database.NodeCollection == "" {
+		cfg.Database.NodeCollection = DefaultNodeCollection
+	}
+	if cfg.Database != nil && cfg.Database.UptimeCollection == "" {
+		cfg.Database.UptimeCollection = DefaultUptimeCollection
+	}
+	if cfg.Database != nil && cfg.Database.SnapshotCollection == "" {
+		cfg.Database.SnapshotCollection = DefaultSnapshotCollection
+	}
+	if cfg.Database != nil && cfg.Database.CrawlRoundCollection == "" {
+		cfg.Database.CrawlRoundCollection = DefaultCrawlRoundCollection
+	}
+	if cfg.Database != nil && cfg.Database.TransitionCollection == "" {
+		cfg.Database.TransitionCollection = DefaultTransitionCollection
+	}
+	if cfg.Crawler == nil {
+		cfg.Crawler = new(Crawler)
+	}
+	if cfg.Crawler.Sync == nil {
+		sync := DefaultSync
+		cfg.Crawler.Sync = &sync
+	}
+	cfg.Crawler.setDefaults()
+	if err = cfg.Crawler.loadEnv(); err != nil {
+		return nil, err
+	}
+	if err = cfg.Crawler.validate(); err != nil {
+		return nil, err
+	}
+	if err = cfg.ForkReadiness.validate(); err != nil {
+		return nil, err
+	}
+
 	// load envs
 	cfg.Database.URI, err = loadDatabaseURI()
 	if err != nil {
@@ -82,6 +481,9 @@ func Load(path string) (*Configuration, error) {
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Server != nil {
+		cfg.Server.AdminToken = os.Getenv("ADMIN_TOKEN")
+	}
 
 	return cfg, nil
 }
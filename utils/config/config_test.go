@@ -0,0 +1,150 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: LGPL-3.0-only
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setEnv(t *testing.T, env map[string]string) {
+	for name, value := range env {
+		require.NoError(t, os.Setenv(name, value))
+	}
+	t.Cleanup(func() {
+		for name := range env {
+			_ = os.Unsetenv(name)
+		}
+	})
+}
+
+func TestLoadDev(t *testing.T) {
+	setEnv(t, map[string]string{
+		"MONGODB_URI":      "mongodb://localhost:27017",
+		"RESOLVER_API_KEY": "key",
+	})
+	cfg, err := Load("../../cmd/config/config.dev.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "mongodb://localhost:27017", cfg.Database.URI)
+	assert.Equal(t, "key", cfg.Resolver.APIKey)
+	assert.Equal(t, "mainnet", cfg.Network.Name)
+	assert.Equal(t, 30304, cfg.Crawler.TCPPort)
+	assert.Equal(t, 24*time.Hour, cfg.Crawler.RecheckInterval)
+	assert.Equal(t, "@hourly", cfg.Crawler.HistorySchedule)
+	assert.Equal(t, &DefaultSync, cfg.Crawler.Sync)
+}
+
+func TestLoadDefaults(t *testing.T) {
+	setEnv(t, map[string]string{
+		"MONGODB_URI":      "mongodb://localhost:27017",
+		"RESOLVER_API_KEY": "key",
+	})
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte("database:\n  database: crawler\nresolver: {}\n"), 0600))
+
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultNetwork, cfg.Network.Name)
+	assert.Equal(t, DefaultENRCollection, cfg.Database.ENRCollection)
+	assert.Equal(t, DefaultTransitionCollection, cfg.Database.TransitionCollection)
+	assert.Equal(t, DefaultCrawler.Concurrency, cfg.Crawler.Concurrency)
+	assert.Equal(t, DefaultCrawler.HistorySchedule, cfg.Crawler.HistorySchedule)
+	assert.Equal(t, DefaultSync, *cfg.Crawler.Sync)
+}
+
+func TestLoadEnv(t *testing.T) {
+	setEnv(t, map[string]string{
+		"CRAWLER_MODE":                CrawlModeSweep,
+		"CRAWLER_SWEEP_EMPTY_BUCKETS": "3",
+		"CRAWLER_LISTEN_ADDRESS":      "127.0.0.1",
+		"CRAWLER_TCP_PORT":            "9000",
+		"CRAWLER_CONCURRENCY":         "10",
+		"CRAWLER_RECHECK_INTERVAL":    "1h",
+		"CRAWLER_HISTORY_SCHEDULE":    "@hourly",
+	})
+	c := DefaultCrawler
+	require.NoError(t, c.loadEnv())
+	assert.Equal(t, CrawlModeSweep, c.Mode)
+	assert.Equal(t, 3, c.SweepEmptyBuckets)
+	assert.Equal(t, DefaultCrawler.SweepMinDistance, c.SweepMinDistance)
+	assert.Equal(t, "127.0.0.1", c.ListenAddress)
+	assert.Equal(t, 9000, c.TCPPort)
+	assert.Equal(t, DefaultCrawler.UDPPort, c.UDPPort)
+	assert.Equal(t, 10, c.Concurrency)
+	assert.Equal(t, time.Hour, c.RecheckInterval)
+	assert.Equal(t, "@hourly", c.HistorySchedule)
+}
+
+func TestLoadEnvInvalid(t *testing.T) {
+	for _, env := range []map[string]string{
+		{"CRAWLER_TCP_PORT": "port"},
+		{"CRAWLER_RETRIES": "1.5"},
+		{"CRAWLER_RETRY_INTERVAL": "30"},
+		{"CRAWLER_RETENTION": "30"},
+	} {
+		t.Run("", func(t *testing.T) {
+			setEnv(t, env)
+			c := DefaultCrawler
+			assert.Error(t, c.loadEnv())
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		apply func(c *Crawler)
+	}{
+		{name: "mode", apply: func(c *Crawler) { c.Mode = "bfs" }},
+		{name: "sweep min distance", apply: func(c *Crawler) { c.SweepMinDistance = 257 }},
+		{name: "sweep empty buckets", apply: func(c *Crawler) { c.SweepEmptyBuckets = -1 }},
+		{name: "listen address", apply: func(c *Crawler) { c.ListenAddress = "localhost" }},
+		{name: "ipv4 listen address6", apply: func(c *Crawler) { c.ListenAddress6 = "0.0.0.0" }},
+		{name: "tcp port", apply: func(c *Crawler) { c.TCPPort = 70000 }},
+		{name: "udp port", apply: func(c *Crawler) { c.UDPPort = -1 }},
+		{name: "user agent", apply: func(c *Crawler) { c.UserAgent = "" }},
+		{name: "concurrency", apply: func(c *Crawler) { c.Concurrency = 0 }},
+		{name: "recheck interval", apply: func(c *Crawler) { c.RecheckInterval = -time.Hour }},
+		{name: "retries", apply: func(c *Crawler) { c.Retries = 0 }},
+		{name: "retry interval", apply: func(c *Crawler) { c.RetryInterval = 0 }},
+		{name: "backoff base", apply: func(c *Crawler) { c.BackoffBase = -time.Minute }},
+		{name: "max backoff", apply: func(c *Crawler) { c.MaxBackoff = time.Minute }},
+		{name: "retention", apply: func(c *Crawler) { c.Retention = 0 }},
+		{name: "finality interval", apply: func(c *Crawler) { c.FinalityInterval = 0 }},
+		{name: "history schedule", apply: func(c *Crawler) { c.HistorySchedule = "hourly" }},
+		{name: "sync thresholds", apply: func(c *Crawler) { c.Sync.SyncedSlots = c.Sync.BehindSlots + 1 }},
+	}
+
+	valid := DefaultCrawler
+	sync := DefaultSync
+	valid.Sync = &sync
+	require.NoError(t, valid.validate())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DefaultCrawler
+			sync := DefaultSync
+			c.Sync = &sync
+			tt.apply(&c)
+			assert.Error(t, c.validate())
+		})
+	}
+}
+
+func TestForkReadinessValidate(t *testing.T) {
+	valid := ForkReadiness{"bellatrix": {"prysm": "v2.1.3", "lighthouse": "2.5.0"}}
+	require.NoError(t, valid.validate())
+
+	invalid := ForkReadiness{"bellatrix": {"prysm": "v2.1.3", "lighthouse": "latest"}}
+	err := invalid.validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "lighthouse")
+	assert.Contains(t, err.Error(), "bellatrix")
+}